@@ -0,0 +1,37 @@
+package gormlike
+
+import (
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Unaccent removes diacritics from the value, like "José" to "Jose", by decomposing its characters and removing the
+// combining marks. It's used on the values of accent-insensitive conditions and can be registered as the unaccent
+// function on SQLite, see the sqlitefunc package.
+func Unaccent(value string) string {
+	// Transformers keep state, so they can't be shared between goroutines
+	unaccenter := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	result, _, err := transform.String(unaccenter, value)
+	if err != nil {
+		return value
+	}
+
+	return result
+}
+
+// unaccentColumn wraps the column in the mechanism the dialect has to compare it without accents. Postgres needs the
+// unaccent extension and SQLite a registered unaccent function for this.
+func unaccentColumn(columnExpression string, dialect string) string {
+	switch dialect {
+	case "mysql":
+		return columnExpression + " COLLATE utf8mb4_0900_ai_ci"
+	case "sqlserver":
+		return columnExpression + " COLLATE Latin1_General_CI_AI"
+	default:
+		return "unaccent(" + columnExpression + ")"
+	}
+}
@@ -0,0 +1,66 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnaccent_ReturnsExpectedValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+
+		expected string
+	}{
+		"empty":                     {value: "", expected: ""},
+		"no accents":                {value: "jose", expected: "jose"},
+		"composed accents":          {value: "José Ñoño", expected: "Jose Nono"},
+		"decomposed accents":        {value: "José", expected: "Jose"},
+		"multiple accents":          {value: "ệ", expected: "e"},
+		"letters without accents":   {value: "ßæøł", expected: "ßæøł"},
+		"wildcards and escapes":     {value: `%é\_%`, expected: `%e\_%`},
+		"other scripts":             {value: "日本語 🍌", expected: "日本語 🍌"},
+		"invalid utf-8 is replaced": {value: "a\xffé", expected: "a\uFFFDe"},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := Unaccent(testData.value)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestUnaccentColumn_ReturnsExpectedExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialect string
+
+		expected string
+	}{
+		"postgres":  {dialect: "postgres", expected: "unaccent(name)"},
+		"sqlite":    {dialect: "sqlite", expected: "unaccent(name)"},
+		"mysql":     {dialect: "mysql", expected: "name COLLATE utf8mb4_0900_ai_ci"},
+		"sqlserver": {dialect: "sqlserver", expected: "name COLLATE Latin1_General_CI_AI"},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := unaccentColumn("name", testData.dialect)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
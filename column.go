@@ -0,0 +1,111 @@
+package gormlike
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// splitColumn returns the table and name of a column in a condition, which is either a string from a map condition
+// like "name" or "users.name", or a clause.Column from a struct condition. It returns false if the column is neither.
+// Database schemas in front of the table, like "public.users.name", are ignored.
+func splitColumn(column any) (string, string, bool) {
+	switch column := column.(type) {
+	case string:
+		parts := strings.Split(column, ".")
+		if len(parts) == 1 {
+			return "", unquote(column), true
+		}
+
+		return unquote(parts[len(parts)-2]), unquote(parts[len(parts)-1]), true
+	case clause.Column:
+		if column.Raw {
+			return "", "", false
+		}
+
+		return column.Table, column.Name, true
+	default:
+		return "", "", false
+	}
+}
+
+// unquote removes the quotes that databases use around identifiers, like "name", `name` and [name]
+func unquote(identifier string) string {
+	return strings.Trim(identifier, "\"`[]")
+}
+
+// columnSQL returns the column as it should appear in the generated condition
+func columnSQL(db *gorm.DB, column any) string {
+	if column, ok := column.(clause.Column); ok {
+		return db.Statement.Quote(column)
+	}
+
+	return fmt.Sprint(column)
+}
+
+// lookupField returns the schema field of the column, or nil if it's unknown. Columns qualified with the name of a
+// relationship, like the ones from db.Joins("Company"), or the table of a relationship are looked up in the schema
+// of that relationship.
+func lookupField(db *gorm.DB, table string, name string) *schema.Field {
+	// Queries using db.Table(...) might not have a schema
+	modelSchema := db.Statement.Schema
+	if modelSchema == nil {
+		return nil
+	}
+
+	if table == "" || table == clause.CurrentTable || table == db.Statement.Table || table == modelSchema.Table {
+		return modelSchema.FieldsByDBName[name]
+	}
+
+	for _, relationship := range modelSchema.Relationships.Relations {
+		if relationship.FieldSchema == nil {
+			continue
+		}
+
+		if relationship.Name == table || relationship.FieldSchema.Table == table {
+			return relationship.FieldSchema.FieldsByDBName[name]
+		}
+	}
+
+	return nil
+}
+
+// columnMatches returns whether the column is one of the given columns, like "name" or "users.name". Columns without
+// a table, or with the current table, belong to the table of the statement.
+func columnMatches(db *gorm.DB, table string, name string, columns []string) bool {
+	if table == "" || table == clause.CurrentTable {
+		table = db.Statement.Table
+	}
+
+	for _, column := range columns {
+		columnTable, columnName, _ := splitColumn(column)
+		if columnName == name && (columnTable == "" || columnTable == table) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldNamed returns a predicate that matches fields with one of the given names, like "email", "users.email" or
+// "User.Email"
+func fieldNamed(names []string) func(*schema.Field) bool {
+	return func(dbField *schema.Field) bool {
+		for _, name := range names {
+			table, fieldName, _ := splitColumn(name)
+
+			if fieldName != dbField.DBName && fieldName != dbField.Name {
+				continue
+			}
+
+			if table == "" || (dbField.Schema != nil && (table == dbField.Schema.Table || table == dbField.Schema.Name)) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
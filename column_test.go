@@ -0,0 +1,207 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+func TestSplitColumn_ReturnsExpectedTableAndName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		column any
+
+		expectedTable string
+		expectedName  string
+		expectedOk    bool
+	}{
+		"string": {
+			column:       "name",
+			expectedName: "name",
+			expectedOk:   true,
+		},
+		"qualified string": {
+			column:        "users.name",
+			expectedTable: "users",
+			expectedName:  "name",
+			expectedOk:    true,
+		},
+		"quoted qualified string": {
+			column:        "`Company`.`name`",
+			expectedTable: "Company",
+			expectedName:  "name",
+			expectedOk:    true,
+		},
+		"double-quoted schema-qualified string": {
+			column:        `"public"."users"."name"`,
+			expectedTable: "users",
+			expectedName:  "name",
+			expectedOk:    true,
+		},
+		"column": {
+			column:        clause.Column{Table: clause.CurrentTable, Name: "name"},
+			expectedTable: clause.CurrentTable,
+			expectedName:  "name",
+			expectedOk:    true,
+		},
+		"raw column": {
+			column:     clause.Column{Name: "LOWER(name)", Raw: true},
+			expectedOk: false,
+		},
+		"expression": {
+			column:     clause.Expr{SQL: "name"},
+			expectedOk: false,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			table, name, ok := splitColumn(testData.column)
+
+			// Assert
+			assert.Equal(t, testData.expectedOk, ok)
+			assert.Equal(t, testData.expectedTable, table)
+			assert.Equal(t, testData.expectedName, name)
+		})
+	}
+}
+
+func TestColumnMatches_ReturnsExpectedResult(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		table   string
+		name    string
+		columns []string
+
+		expected bool
+	}{
+		"no columns": {
+			name: "name",
+		},
+		"name": {
+			name:     "name",
+			columns:  []string{"email", "name"},
+			expected: true,
+		},
+		"other name": {
+			name:    "name",
+			columns: []string{"email"},
+		},
+		"qualified column of statement table": {
+			name:     "name",
+			columns:  []string{"users.name"},
+			expected: true,
+		},
+		"qualified column of current table": {
+			table:    clause.CurrentTable,
+			name:     "name",
+			columns:  []string{"users.name"},
+			expected: true,
+		},
+		"qualified column of other table": {
+			name:    "name",
+			columns: []string{"companies.name"},
+		},
+		"column of joined table": {
+			table:    "companies",
+			name:     "name",
+			columns:  []string{"companies.name"},
+			expected: true,
+		},
+		"unqualified column for joined table": {
+			table:    "companies",
+			name:     "name",
+			columns:  []string{"name"},
+			expected: true,
+		},
+		"quoted column": {
+			name:     "name",
+			columns:  []string{"`users`.`name`"},
+			expected: true,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := &gorm.DB{Statement: &gorm.Statement{Table: "users"}}
+
+			// Act
+			result := columnMatches(db, testData.table, testData.name, testData.columns)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestFieldNamed_ReturnsExpectedResult(t *testing.T) {
+	t.Parallel()
+
+	userSchema := &schema.Schema{Name: "User", Table: "users"}
+
+	tests := map[string]struct {
+		field *schema.Field
+		names []string
+
+		expected bool
+	}{
+		"no names": {
+			field: &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+		},
+		"column name": {
+			field:    &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+			names:    []string{"name", "email"},
+			expected: true,
+		},
+		"field name": {
+			field:    &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+			names:    []string{"Email"},
+			expected: true,
+		},
+		"table-qualified name": {
+			field:    &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+			names:    []string{"users.email"},
+			expected: true,
+		},
+		"model-qualified name": {
+			field:    &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+			names:    []string{"User.Email"},
+			expected: true,
+		},
+		"name of other table": {
+			field: &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+			names: []string{"companies.email"},
+		},
+		"other name": {
+			field: &schema.Field{Name: "Email", DBName: "email", Schema: userSchema},
+			names: []string{"users.name"},
+		},
+		"qualified name without schema": {
+			field: &schema.Field{Name: "Email", DBName: "email"},
+			names: []string{"users.email"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := fieldNamed(testData.names)(testData.field)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package gormlike
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// conditionOptions changes the way a LIKE condition is built
+type conditionOptions struct {
+	caseInsensitive   bool
+	accentInsensitive bool
+	negated           bool
+	prefixRanges      bool
+	glob              bool
+}
+
+// patternCondition returns the condition for a pattern on a field, which is a full-text search for fields with the fts
+// tag on databases that support it and a LIKE condition otherwise
+func patternCondition(db *gorm.DB, column any, policy *fieldPolicy, pattern string, opts conditionOptions) (string, []any) {
+	if policy.tag.fullText {
+		if condition, vars, ok := fullTextCondition(db, column, policy.table, pattern, opts.negated); ok {
+			return condition, vars
+		}
+	}
+
+	return likeCondition(db, column, policy.kind, pattern, opts)
+}
+
+// likeCondition returns the LIKE condition for the given column and pattern, including an ESCAPE clause if the pattern
+// contains escaped characters. Case-insensitive conditions use ILIKE on Postgres and LOWER() elsewhere, accents are
+// removed from the pattern of accent-insensitive conditions and ignored in the column using unaccentColumn. With the
+// glob option, case-sensitive conditions on SQLite use GLOB instead.
+func likeCondition(db *gorm.DB, column any, kind likeKind, pattern string, opts conditionOptions) (string, []any) {
+	columnExpression := columnSQL(db, column)
+
+	// Ranges only match the same records as LIKE if the column's text is compared as-is
+	if opts.prefixRanges && !opts.caseInsensitive && !opts.accentInsensitive && kind == textField {
+		if prefix, ok := patternPrefix(pattern); ok {
+			return rangeCondition(columnExpression, prefix, opts.negated)
+		}
+	}
+
+	columnExpression = comparedColumn(db, columnExpression, kind, opts)
+	if opts.accentInsensitive {
+		pattern = Unaccent(pattern)
+	}
+
+	// GLOB is case-sensitive, unlike LIKE on SQLite
+	if opts.glob && !opts.caseInsensitive && db.Dialector.Name() == "sqlite" {
+		operator := "GLOB"
+		if opts.negated {
+			operator = "NOT GLOB"
+		}
+
+		return fmt.Sprintf("%s %s ?", columnExpression, operator), []any{globPattern(pattern)}
+	}
+
+	lowerCase := opts.caseInsensitive && db.Dialector.Name() != "postgres"
+
+	operator := "LIKE"
+	if opts.caseInsensitive && !lowerCase {
+		operator = "ILIKE"
+	}
+
+	if opts.negated {
+		operator = "NOT " + operator
+	}
+
+	condition := fmt.Sprintf("%s %s ?", columnExpression, operator)
+	if lowerCase {
+		condition = fmt.Sprintf("LOWER(%s) %s LOWER(?)", columnExpression, operator)
+	}
+
+	if !strings.Contains(pattern, sqlEscapeCharacter) {
+		return condition, []any{pattern}
+	}
+
+	return condition + " ESCAPE ?", []any{pattern, sqlEscapeCharacter}
+}
+
+// regexCondition returns the condition matching the column against the regular expression, using ~ on Postgres and
+// REGEXP elsewhere. Case-insensitive conditions use the (?i) flag, which all of these support.
+func regexCondition(db *gorm.DB, column any, kind likeKind, expression string, opts conditionOptions) (string, []any) {
+	columnExpression := comparedColumn(db, columnSQL(db, column), kind, opts)
+	if opts.accentInsensitive {
+		expression = Unaccent(expression)
+	}
+
+	if opts.caseInsensitive {
+		expression = "(?i)" + expression
+	}
+
+	var operator string
+
+	switch {
+	case db.Dialector.Name() == "postgres" && opts.negated:
+		operator = "!~"
+	case db.Dialector.Name() == "postgres":
+		operator = "~"
+	case opts.negated:
+		operator = "NOT REGEXP"
+	default:
+		operator = "REGEXP"
+	}
+
+	return fmt.Sprintf("%s %s ?", columnExpression, operator), []any{expression}
+}
+
+// comparedColumn returns the column expression as it should be compared to a pattern, which is cast to text if
+// necessary and ignores accents for accent-insensitive conditions
+func comparedColumn(db *gorm.DB, columnExpression string, kind likeKind, opts conditionOptions) string {
+	if kind == castField {
+		columnExpression = fmt.Sprintf("CAST(%s AS %s)", columnExpression, castType(db.Dialector.Name()))
+	}
+
+	if opts.accentInsensitive {
+		columnExpression = unaccentColumn(columnExpression, db.Dialector.Name())
+	}
+
+	return columnExpression
+}
+
+// rangeCondition returns a condition matching all values that start with the prefix, using comparisons that
+// databases can use a B-tree index for
+func rangeCondition(columnExpression string, prefix string, negated bool) (string, []any) {
+	upperBound, ok := prefixUpperBound(prefix)
+
+	switch {
+	case !ok && negated:
+		return fmt.Sprintf("%s < ?", columnExpression), []any{prefix}
+	case !ok:
+		return fmt.Sprintf("%s >= ?", columnExpression), []any{prefix}
+	case negated:
+		return fmt.Sprintf("(%s < ? OR %s >= ?)", columnExpression, columnExpression), []any{prefix, upperBound}
+	default:
+		return fmt.Sprintf("(%s >= ? AND %s < ?)", columnExpression, columnExpression), []any{prefix, upperBound}
+	}
+}
+
+// likeKind describes whether and how a field can be used in a LIKE query
+type likeKind int
+
+const (
+	// textField columns can be used in a LIKE query as-is
+	textField likeKind = iota
+
+	// castField columns have to be cast to text first, like numbers, times and UUIDs
+	castField
+
+	// unlikeableField columns can't be meaningfully used in a LIKE query, like binary data
+	unlikeableField
+)
+
+// fieldKind classifies the field using its data type. Fields not found in the schema are assumed to be text.
+func fieldKind(dbField *schema.Field) likeKind {
+	if dbField == nil {
+		return textField
+	}
+
+	//nolint:exhaustive // Custom data types are handled in the default case
+	switch dbField.DataType {
+	case schema.String:
+		fieldType := dbField.FieldType
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		// Custom types that are stored as a string, like uuid.UUID, may have a different column type in the database
+		if fieldType.Kind() != reflect.String {
+			return castField
+		}
+
+		return textField
+	case schema.Int, schema.Uint, schema.Float, schema.Bool, schema.Time:
+		return castField
+	case schema.Bytes, "":
+		return unlikeableField
+	default:
+		// Custom column types, like `gorm:"type:uuid"`
+		return castField
+	}
+}
+
+// castType returns the type that columns are cast to on the given dialect
+func castType(dialect string) string {
+	switch dialect {
+	case "postgres", "sqlite":
+		return "TEXT"
+	case "mysql":
+		return "CHAR"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	default:
+		return "VARCHAR"
+	}
+}
@@ -0,0 +1,483 @@
+package gormlike
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// testDialector only exists to return a dialector name
+type testDialector struct {
+	gorm.Dialector
+	name string
+}
+
+func (t testDialector) Name() string {
+	return t.name
+}
+
+func (t testDialector) QuoteTo(writer clause.Writer, value string) {
+	_ = writer.WriteByte('"')
+	_, _ = writer.WriteString(value)
+	_ = writer.WriteByte('"')
+}
+
+func (t testDialector) BindVarTo(writer clause.Writer, _ *gorm.Statement, _ any) {
+	_ = writer.WriteByte('?')
+}
+
+// newTestDB returns a database that only provides a dialector name and quoting, for tests that generate SQL
+func newTestDB(dialector string) *gorm.DB {
+	return &gorm.DB{Config: &gorm.Config{Dialector: testDialector{name: dialector}}}
+}
+
+func TestLikeCondition_ReturnsExpectedCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialector string
+		field     *schema.Field
+		pattern   string
+		opts      conditionOptions
+
+		expectedCondition string
+		expectedVars      []any
+	}{
+		"sqlite": {
+			dialector:         "sqlite",
+			pattern:           "%a%",
+			expectedCondition: "name LIKE ?",
+			expectedVars:      []any{"%a%"},
+		},
+		"sqlite with escaping": {
+			dialector:         "sqlite",
+			pattern:           `%a\_%`,
+			expectedCondition: "name LIKE ? ESCAPE ?",
+			expectedVars:      []any{`%a\_%`, `\`},
+		},
+		"sqlite case-insensitive": {
+			dialector:         "sqlite",
+			pattern:           "%a%",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "LOWER(name) LIKE LOWER(?)",
+			expectedVars:      []any{"%a%"},
+		},
+		"mysql case-insensitive": {
+			dialector:         "mysql",
+			pattern:           "%a%",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "LOWER(name) LIKE LOWER(?)",
+			expectedVars:      []any{"%a%"},
+		},
+		"postgres with cast": {
+			dialector:         "postgres",
+			field:             &schema.Field{DBName: "age", DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			pattern:           "%1%",
+			expectedCondition: "CAST(name AS TEXT) LIKE ?",
+			expectedVars:      []any{"%1%"},
+		},
+		"mysql case-insensitive with cast": {
+			dialector:         "mysql",
+			field:             &schema.Field{DBName: "id", DataType: schema.String, FieldType: reflect.TypeOf(uuid.UUID{})},
+			pattern:           "%a%",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "LOWER(CAST(name AS CHAR)) LIKE LOWER(?)",
+			expectedVars:      []any{"%a%"},
+		},
+		"sqlite negated": {
+			dialector:         "sqlite",
+			pattern:           "%a%",
+			opts:              conditionOptions{negated: true},
+			expectedCondition: "name NOT LIKE ?",
+			expectedVars:      []any{"%a%"},
+		},
+		"sqlite negated case-insensitive": {
+			dialector:         "sqlite",
+			pattern:           "%a%",
+			opts:              conditionOptions{caseInsensitive: true, negated: true},
+			expectedCondition: "LOWER(name) NOT LIKE LOWER(?)",
+			expectedVars:      []any{"%a%"},
+		},
+		"postgres negated case-insensitive": {
+			dialector:         "postgres",
+			pattern:           "%a%",
+			opts:              conditionOptions{caseInsensitive: true, negated: true},
+			expectedCondition: "name NOT ILIKE ?",
+			expectedVars:      []any{"%a%"},
+		},
+		"prefix range": {
+			dialector:         "postgres",
+			pattern:           "ab%",
+			opts:              conditionOptions{prefixRanges: true},
+			expectedCondition: "(name >= ? AND name < ?)",
+			expectedVars:      []any{"ab", "ac"},
+		},
+		"negated prefix range": {
+			dialector:         "postgres",
+			pattern:           "ab%",
+			opts:              conditionOptions{prefixRanges: true, negated: true},
+			expectedCondition: "(name < ? OR name >= ?)",
+			expectedVars:      []any{"ab", "ac"},
+		},
+		"prefix range without upper bound": {
+			dialector:         "postgres",
+			pattern:           "\U0010FFFF%",
+			opts:              conditionOptions{prefixRanges: true},
+			expectedCondition: "name >= ?",
+			expectedVars:      []any{"\U0010FFFF"},
+		},
+		"prefix range is not used for other patterns": {
+			dialector:         "postgres",
+			pattern:           "%ab%",
+			opts:              conditionOptions{prefixRanges: true},
+			expectedCondition: "name LIKE ?",
+			expectedVars:      []any{"%ab%"},
+		},
+		"prefix range is not used for case-insensitive conditions": {
+			dialector:         "postgres",
+			pattern:           "ab%",
+			opts:              conditionOptions{prefixRanges: true, caseInsensitive: true},
+			expectedCondition: "name ILIKE ?",
+			expectedVars:      []any{"ab%"},
+		},
+		"prefix range is not used for cast columns": {
+			dialector:         "postgres",
+			field:             &schema.Field{DBName: "age", DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			pattern:           "1%",
+			opts:              conditionOptions{prefixRanges: true},
+			expectedCondition: "CAST(name AS TEXT) LIKE ?",
+			expectedVars:      []any{"1%"},
+		},
+		"postgres": {
+			dialector:         "postgres",
+			pattern:           "%a%",
+			expectedCondition: "name LIKE ?",
+			expectedVars:      []any{"%a%"},
+		},
+		"postgres accent-insensitive": {
+			dialector:         "postgres",
+			pattern:           "%josé%",
+			opts:              conditionOptions{accentInsensitive: true},
+			expectedCondition: "unaccent(name) LIKE ?",
+			expectedVars:      []any{"%jose%"},
+		},
+		"postgres case- and accent-insensitive": {
+			dialector:         "postgres",
+			pattern:           "%josé%",
+			opts:              conditionOptions{accentInsensitive: true, caseInsensitive: true},
+			expectedCondition: "unaccent(name) ILIKE ?",
+			expectedVars:      []any{"%jose%"},
+		},
+		"mysql accent-insensitive": {
+			dialector:         "mysql",
+			pattern:           `%jos\_é%`,
+			opts:              conditionOptions{accentInsensitive: true, negated: true},
+			expectedCondition: "name COLLATE utf8mb4_0900_ai_ci NOT LIKE ? ESCAPE ?",
+			expectedVars:      []any{`%jos\_e%`, `\`},
+		},
+		"sqlite case- and accent-insensitive with cast": {
+			dialector:         "sqlite",
+			field:             &schema.Field{DBName: "age", DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			pattern:           "%1%",
+			opts:              conditionOptions{accentInsensitive: true, caseInsensitive: true},
+			expectedCondition: "LOWER(unaccent(CAST(name AS TEXT))) LIKE LOWER(?)",
+			expectedVars:      []any{"%1%"},
+		},
+		"prefix range is not used for accent-insensitive conditions": {
+			dialector:         "postgres",
+			pattern:           "ab%",
+			opts:              conditionOptions{prefixRanges: true, accentInsensitive: true},
+			expectedCondition: "unaccent(name) LIKE ?",
+			expectedVars:      []any{"ab%"},
+		},
+		"postgres case-insensitive": {
+			dialector:         "postgres",
+			pattern:           "%a%",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "name ILIKE ?",
+			expectedVars:      []any{"%a%"},
+		},
+		"sqlite glob": {
+			dialector:         "sqlite",
+			pattern:           `%a\_*%`,
+			opts:              conditionOptions{glob: true},
+			expectedCondition: "name GLOB ?",
+			expectedVars:      []any{"*a_[*]*"},
+		},
+		"sqlite negated glob": {
+			dialector:         "sqlite",
+			pattern:           "a_%",
+			opts:              conditionOptions{glob: true, negated: true},
+			expectedCondition: "name NOT GLOB ?",
+			expectedVars:      []any{"a?*"},
+		},
+		"sqlite glob with cast": {
+			dialector:         "sqlite",
+			field:             &schema.Field{DBName: "name", DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			pattern:           "4%",
+			opts:              conditionOptions{glob: true},
+			expectedCondition: "CAST(name AS TEXT) GLOB ?",
+			expectedVars:      []any{"4*"},
+		},
+		"sqlite case-insensitive glob": {
+			dialector:         "sqlite",
+			pattern:           "%a%",
+			opts:              conditionOptions{glob: true, caseInsensitive: true},
+			expectedCondition: "LOWER(name) LIKE LOWER(?)",
+			expectedVars:      []any{"%a%"},
+		},
+		"sqlite glob with prefix ranges": {
+			dialector:         "sqlite",
+			pattern:           "ab%",
+			opts:              conditionOptions{glob: true, prefixRanges: true},
+			expectedCondition: "(name >= ? AND name < ?)",
+			expectedVars:      []any{"ab", "ac"},
+		},
+		"postgres glob": {
+			dialector:         "postgres",
+			pattern:           "%a%",
+			opts:              conditionOptions{glob: true},
+			expectedCondition: "name LIKE ?",
+			expectedVars:      []any{"%a%"},
+		},
+		"postgres case-insensitive with escaping": {
+			dialector:         "postgres",
+			pattern:           `%a\%`,
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "name ILIKE ? ESCAPE ?",
+			expectedVars:      []any{`%a\%`, `\`},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newTestDB(testData.dialector)
+
+			field := testData.field
+			if field == nil {
+				field = &schema.Field{DBName: "name", DataType: schema.String, FieldType: reflect.TypeOf("")}
+			}
+
+			// Act
+			condition, vars := likeCondition(db, "name", fieldKind(field), testData.pattern, testData.opts)
+
+			// Assert
+			assert.Equal(t, testData.expectedCondition, condition)
+			assert.Equal(t, testData.expectedVars, vars)
+		})
+	}
+}
+
+func TestRegexCondition_ReturnsExpectedCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialector  string
+		field      *schema.Field
+		expression string
+		opts       conditionOptions
+
+		expectedCondition string
+		expectedVars      []any
+	}{
+		"sqlite": {
+			dialector:         "sqlite",
+			expression:        "^a.*z$",
+			expectedCondition: "name REGEXP ?",
+			expectedVars:      []any{"^a.*z$"},
+		},
+		"sqlite negated": {
+			dialector:         "sqlite",
+			expression:        "^a",
+			opts:              conditionOptions{negated: true},
+			expectedCondition: "name NOT REGEXP ?",
+			expectedVars:      []any{"^a"},
+		},
+		"mysql": {
+			dialector:         "mysql",
+			expression:        "^a",
+			expectedCondition: "name REGEXP ?",
+			expectedVars:      []any{"^a"},
+		},
+		"mysql case-insensitive": {
+			dialector:         "mysql",
+			expression:        "^a",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "name REGEXP ?",
+			expectedVars:      []any{"(?i)^a"},
+		},
+		"mysql with cast": {
+			dialector:         "mysql",
+			field:             &schema.Field{DBName: "name", DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			expression:        "^4[0-9]$",
+			expectedCondition: "CAST(name AS CHAR) REGEXP ?",
+			expectedVars:      []any{"^4[0-9]$"},
+		},
+		"postgres": {
+			dialector:         "postgres",
+			expression:        "^a",
+			expectedCondition: "name ~ ?",
+			expectedVars:      []any{"^a"},
+		},
+		"postgres negated": {
+			dialector:         "postgres",
+			expression:        "^a",
+			opts:              conditionOptions{negated: true},
+			expectedCondition: "name !~ ?",
+			expectedVars:      []any{"^a"},
+		},
+		"postgres case-insensitive": {
+			dialector:         "postgres",
+			expression:        "^a",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "name ~ ?",
+			expectedVars:      []any{"(?i)^a"},
+		},
+		"postgres accent-insensitive": {
+			dialector:         "postgres",
+			expression:        "^jos[eé]$",
+			opts:              conditionOptions{accentInsensitive: true},
+			expectedCondition: "unaccent(name) ~ ?",
+			expectedVars:      []any{"^jos[ee]$"},
+		},
+		"prefix ranges are not used": {
+			dialector:         "postgres",
+			expression:        "^a",
+			opts:              conditionOptions{prefixRanges: true},
+			expectedCondition: "name ~ ?",
+			expectedVars:      []any{"^a"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newTestDB(testData.dialector)
+
+			field := testData.field
+			if field == nil {
+				field = &schema.Field{DBName: "name", DataType: schema.String, FieldType: reflect.TypeOf("")}
+			}
+
+			// Act
+			condition, vars := regexCondition(db, "name", fieldKind(field), testData.expression, testData.opts)
+
+			// Assert
+			assert.Equal(t, testData.expectedCondition, condition)
+			assert.Equal(t, testData.expectedVars, vars)
+		})
+	}
+}
+
+func TestFieldKind_ReturnsExpectedKind(t *testing.T) {
+	t.Parallel()
+
+	type customString string
+
+	tests := map[string]struct {
+		field *schema.Field
+
+		expected likeKind
+	}{
+		"unknown field": {
+			field:    nil,
+			expected: textField,
+		},
+		"string": {
+			field:    &schema.Field{DataType: schema.String, FieldType: reflect.TypeOf("")},
+			expected: textField,
+		},
+		"string pointer": {
+			field:    &schema.Field{DataType: schema.String, FieldType: reflect.TypeOf(new(string))},
+			expected: textField,
+		},
+		"custom string": {
+			field:    &schema.Field{DataType: schema.String, FieldType: reflect.TypeOf(customString(""))},
+			expected: textField,
+		},
+		"uuid": {
+			field:    &schema.Field{DataType: schema.String, FieldType: reflect.TypeOf(uuid.UUID{})},
+			expected: castField,
+		},
+		"string with uuid column type": {
+			field:    &schema.Field{DataType: "uuid", FieldType: reflect.TypeOf("")},
+			expected: castField,
+		},
+		"int": {
+			field:    &schema.Field{DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			expected: castField,
+		},
+		"uint": {
+			field:    &schema.Field{DataType: schema.Uint, FieldType: reflect.TypeOf(uint(0))},
+			expected: castField,
+		},
+		"float": {
+			field:    &schema.Field{DataType: schema.Float, FieldType: reflect.TypeOf(0.0)},
+			expected: castField,
+		},
+		"bool": {
+			field:    &schema.Field{DataType: schema.Bool, FieldType: reflect.TypeOf(false)},
+			expected: castField,
+		},
+		"time": {
+			field:    &schema.Field{DataType: schema.Time, FieldType: reflect.TypeOf(time.Time{})},
+			expected: castField,
+		},
+		"bytes": {
+			field:    &schema.Field{DataType: schema.Bytes, FieldType: reflect.TypeOf([]byte{})},
+			expected: unlikeableField,
+		},
+		"unsupported type": {
+			field:    &schema.Field{DataType: "", FieldType: reflect.TypeOf(map[string]string{})},
+			expected: unlikeableField,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := fieldKind(testData.field)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestCastType_ReturnsExpectedType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"postgres":  "TEXT",
+		"sqlite":    "TEXT",
+		"mysql":     "CHAR",
+		"sqlserver": "NVARCHAR(MAX)",
+		"other":     "VARCHAR",
+	}
+
+	for dialect, expected := range tests {
+		dialect, expected := dialect, expected
+		t.Run(dialect, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := castType(dialect)
+
+			// Assert
+			assert.Equal(t, expected, result)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package gormlike
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnknownField is returned in strict mode when a LIKE pattern is given for a column that's not in the schema
+	ErrUnknownField = errors.New("gormlike: unknown field")
+
+	// ErrNotLikeable is returned in strict mode when a LIKE pattern is given for a column that can't be LIKE-d, like
+	// binary data or raw SQL expressions
+	ErrNotLikeable = errors.New("gormlike: field can't be LIKE-d")
+
+	// ErrTagForbidden is returned in strict mode when a LIKE pattern is given for a column that's not like-able
+	// according to its `gormlike` tag
+	ErrTagForbidden = errors.New("gormlike: field is not like-able according to its tag")
+
+	// ErrTooManyWildcards is returned when a pattern contains more wildcards than allowed by WithMaxWildcards
+	ErrTooManyWildcards = errors.New("gormlike: pattern contains too many wildcards")
+
+	// ErrLeadingWildcard is returned when a pattern starts with a wildcard and WithForbidLeadingWildcard is used
+	ErrLeadingWildcard = errors.New("gormlike: pattern starts with a wildcard")
+
+	// ErrPatternShape is returned when a field only allows certain patterns, like `gormlike:"prefix-only"`, and a
+	// pattern of a different shape was given
+	ErrPatternShape = errors.New("gormlike: pattern shape is not allowed for this field")
+
+	// ErrInvalidRegex is returned when a value wrapped in the delimiter of WithRegexDelimiter is not a valid regular
+	// expression
+	ErrInvalidRegex = errors.New("gormlike: invalid regular expression")
+
+	// ErrUnsafeRegex is returned when a regular expression contains nested quantifiers, like (a+)+, which can take
+	// exponential time in the backtracking regular expression engines of databases
+	ErrUnsafeRegex = errors.New("gormlike: regular expression contains nested quantifiers")
+
+	// ErrInvalidTag is returned for every query on a model that has a `gormlike` tag with unknown settings or invalid
+	// arguments
+	ErrInvalidTag = errors.New("gormlike: invalid tag")
+
+	// ErrFieldNotAllowed is returned in strict mode when a LIKE pattern is given for a column that's not like-able
+	// according to the options of the plugin or query, like the Fields of QueryOptions
+	ErrFieldNotAllowed = errors.New("gormlike: field is not like-able according to the options")
+
+	// ErrInvalidQueryOptions is returned when the "gormlike:options" setting of a query is not a QueryOptions
+	ErrInvalidQueryOptions = errors.New("gormlike: invalid query options")
+
+	// ErrPatternTooLong is returned when a pattern is longer than allowed by WithMaxPatternLength
+	ErrPatternTooLong = errors.New("gormlike: pattern is too long")
+)
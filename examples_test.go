@@ -1,8 +1,11 @@
 package gormlike
 
 import (
+	"log/slog"
+
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func ExampleNew() {
@@ -11,6 +14,57 @@ func ExampleNew() {
 	_ = db.Use(New())
 
 	_ = db.Use(New(WithCharacter("*")))
+	_ = db.Use(New(WithSingleCharacter("?")))
+	_ = db.Use(New(WithEscapeCharacter(`\`)))
+	_ = db.Use(New(WithRegexDelimiter("/")))
+	_ = db.Use(New(WithFuzzyPrefix("~"), WithFuzzyThreshold(0.4), OrderByFuzzySimilarity()))
 	_ = db.Use(New(TaggedOnly()))
+	_ = db.Use(New(WithFields("users.name", "users.email"), WithoutFields("users.password")))
 	_ = db.Use(New(SettingOnly()))
+	_ = db.Use(New(CaseInsensitive()))
+	_ = db.Use(New(AccentInsensitive()))
+	_ = db.Use(New(OptimizePrefixPatterns()))
+	_ = db.Use(New(SQLiteGlob()))
+	_ = db.Use(New(Strict()))
+	_ = db.Use(New(OnUpdate(), OnDelete(), OnRow()))
+	_ = db.Use(New(WithMaxWildcards(2), WithMaxPatternLength(20), WithForbidLeadingWildcard()))
+	_ = db.Use(New(WithLogger(slog.Default())))
+}
+
+func ExampleQueryOptions() {
+	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	_ = db.Use(New())
+
+	db.Set("gormlike:options", QueryOptions{Character: "*", CaseInsensitive: true, Fields: []string{"name"}}).
+		Where(map[string]any{"name": "*jes*"})
+}
+
+func ExampleContains() {
+	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	db.Where(Contains("name", "100%"))
+	db.Where(ILike(StartsWith("name", "jes")))
+	db.Where(clause.Not(EndsWith("email", "@example.com")))
+}
+
+func ExampleLike() {
+	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	db.Where(Like{Column: "name", Value: "jes%"})
+	db.Where(ILike{Column: clause.Column{Table: "users", Name: "name"}, Value: "%ica"})
+}
+
+func ExampleSearch() {
+	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	db.Scopes(Search("jes", "name", "email", "description")).Where("active = ?", true)
+}
+
+func ExampleSearchWords() {
+	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	_ = db.Use(New(WithCharacter("*")))
+
+	db.Scopes(SearchWords(`"van der" j*n`, "first_name", "last_name"))
 }
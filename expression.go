@@ -0,0 +1,110 @@
+package gormlike
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Compile-time interface checks
+var (
+	_ clause.Expression                = Like{}
+	_ clause.NegationExpressionBuilder = Like{}
+	_ clause.Expression                = ILike{}
+	_ clause.NegationExpressionBuilder = ILike{}
+)
+
+// Like is a LIKE condition that can be used in db.Where(...) regardless of the plugin and its options, like
+// db.Where(gormlike.Like{Column: "name", Value: "jes%"}). The % and _ in Value are wildcards, use StartsWith,
+// EndsWith or Contains to search for user input literally. Columns that aren't text are cast like the plugin does and
+// clause.Not(...) results in NOT LIKE.
+type Like struct {
+	// Column is a column name like "name" or "users.name", or a clause.Column
+	Column any
+
+	// Value is the pattern that the column should match
+	Value string
+}
+
+// Build writes the LIKE condition for the dialect of the statement
+func (like Like) Build(builder clause.Builder) {
+	buildLike(builder, like.Column, like.Value, conditionOptions{})
+}
+
+// NegationBuild writes the NOT LIKE condition for the dialect of the statement
+func (like Like) NegationBuild(builder clause.Builder) {
+	buildLike(builder, like.Column, like.Value, conditionOptions{negated: true})
+}
+
+// ILike is a case-insensitive version of Like, which results in ILIKE on Postgres and a comparison of LOWER() values
+// elsewhere. It can also be used to make the result of StartsWith, EndsWith or Contains case-insensitive, like
+// gormlike.ILike(gormlike.Contains("name", input)).
+type ILike Like
+
+// Build writes the case-insensitive LIKE condition for the dialect of the statement
+func (like ILike) Build(builder clause.Builder) {
+	buildLike(builder, like.Column, like.Value, conditionOptions{caseInsensitive: true})
+}
+
+// NegationBuild writes the case-insensitive NOT LIKE condition for the dialect of the statement
+func (like ILike) NegationBuild(builder clause.Builder) {
+	buildLike(builder, like.Column, like.Value, conditionOptions{caseInsensitive: true, negated: true})
+}
+
+// StartsWith returns a LIKE condition that matches values of the column starting with the given value, which is
+// taken literally
+func StartsWith(column any, value string) Like {
+	return Like{Column: column, Value: escapeLiteral(value) + "%"}
+}
+
+// EndsWith returns a LIKE condition that matches values of the column ending with the given value, which is taken
+// literally
+func EndsWith(column any, value string) Like {
+	return Like{Column: column, Value: "%" + escapeLiteral(value)}
+}
+
+// Contains returns a LIKE condition that matches values of the column containing the given value, which is taken
+// literally
+func Contains(column any, value string) Like {
+	return Like{Column: column, Value: "%" + escapeLiteral(value) + "%"}
+}
+
+// buildLike writes the LIKE condition of the column and pattern to the builder, columns given as a string are quoted
+func buildLike(builder clause.Builder, column any, pattern string, opts conditionOptions) {
+	if name, ok := column.(string); ok {
+		column = clause.Column{Name: name}
+	}
+
+	stmt, ok := builder.(*gorm.Statement)
+	if !ok {
+		// Without a statement the dialect is unknown, so this is the best we can do
+		builder.WriteQuoted(column)
+
+		if opts.negated {
+			builder.WriteString(" NOT")
+		}
+
+		builder.WriteString(" LIKE ")
+		builder.AddVar(builder, pattern)
+
+		return
+	}
+
+	db := statementDB(stmt)
+
+	if plugin := registeredPlugin(db); plugin != nil {
+		opts.glob = plugin.sqliteGlob
+	}
+
+	kind := textField
+	if table, name, ok := splitColumn(column); ok {
+		kind = fieldKind(lookupField(db, table, name))
+	}
+
+	condition, vars := likeCondition(db, column, kind, pattern, opts)
+	clause.Expr{SQL: condition, Vars: vars}.Build(builder)
+}
+
+// statementDB returns a DB for the statement, which may not be the one of its own DB, like in subqueries
+func statementDB(stmt *gorm.Statement) *gorm.DB {
+	return &gorm.DB{Config: stmt.DB.Config, Statement: stmt}
+}
@@ -0,0 +1,249 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ing-bank/gormtestutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func TestLike_Build_ReturnsExpectedSQL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialector  string
+		expression clause.Expression
+
+		expectedSQL  string
+		expectedVars []any
+	}{
+		"like": {
+			dialector:    "sqlite",
+			expression:   Like{Column: "name", Value: "a%b_"},
+			expectedSQL:  `"name" LIKE ?`,
+			expectedVars: []any{"a%b_"},
+		},
+		"like with escaping": {
+			dialector:    "sqlite",
+			expression:   Like{Column: "name", Value: `a\%`},
+			expectedSQL:  `"name" LIKE ? ESCAPE ?`,
+			expectedVars: []any{`a\%`, `\`},
+		},
+		"like with clause column": {
+			dialector:    "sqlite",
+			expression:   Like{Column: clause.Column{Table: "users", Name: "name"}, Value: "a%"},
+			expectedSQL:  `"users"."name" LIKE ?`,
+			expectedVars: []any{"a%"},
+		},
+		"not like": {
+			dialector:    "sqlite",
+			expression:   clause.Not(Like{Column: "name", Value: "a%"}),
+			expectedSQL:  `"name" NOT LIKE ?`,
+			expectedVars: []any{"a%"},
+		},
+		"ilike on postgres": {
+			dialector:    "postgres",
+			expression:   ILike{Column: "name", Value: "a%"},
+			expectedSQL:  `"name" ILIKE ?`,
+			expectedVars: []any{"a%"},
+		},
+		"ilike on mysql": {
+			dialector:    "mysql",
+			expression:   ILike{Column: "name", Value: "a%"},
+			expectedSQL:  `LOWER("name") LIKE LOWER(?)`,
+			expectedVars: []any{"a%"},
+		},
+		"not ilike on postgres": {
+			dialector:    "postgres",
+			expression:   clause.Not(ILike{Column: "name", Value: "a%"}),
+			expectedSQL:  `"name" NOT ILIKE ?`,
+			expectedVars: []any{"a%"},
+		},
+		"starts with": {
+			dialector:    "postgres",
+			expression:   StartsWith("name", "a%b"),
+			expectedSQL:  `"name" LIKE ? ESCAPE ?`,
+			expectedVars: []any{`a\%b%`, `\`},
+		},
+		"ends with": {
+			dialector:    "postgres",
+			expression:   EndsWith("name", "a_b"),
+			expectedSQL:  `"name" LIKE ? ESCAPE ?`,
+			expectedVars: []any{`%a\_b`, `\`},
+		},
+		"contains": {
+			dialector:    "postgres",
+			expression:   Contains("name", `a\b`),
+			expectedSQL:  `"name" LIKE ? ESCAPE ?`,
+			expectedVars: []any{`%a\\b%`, `\`},
+		},
+		"contains without special characters": {
+			dialector:    "postgres",
+			expression:   Contains("name", "ab"),
+			expectedSQL:  `"name" LIKE ?`,
+			expectedVars: []any{"%ab%"},
+		},
+		"case-insensitive contains": {
+			dialector:    "postgres",
+			expression:   ILike(Contains("name", "ab")),
+			expectedSQL:  `"name" ILIKE ?`,
+			expectedVars: []any{"%ab%"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newTestDB(testData.dialector)
+			stmt := &gorm.Statement{DB: db, Clauses: map[string]clause.Clause{}}
+			db.Statement = stmt
+
+			// Act
+			testData.expression.Build(stmt)
+
+			// Assert
+			assert.Equal(t, testData.expectedSQL, stmt.SQL.String())
+			assert.Equal(t, testData.expectedVars, stmt.Vars)
+		})
+	}
+}
+
+func TestLike_Build_QueriesExpectedRecords(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		ID   uuid.UUID
+		Name string
+		Age  int
+	}
+
+	id := uuid.MustParse("9f8e3a66-4ad1-4c42-9ba4-5f4fe1e6b6a0")
+
+	tests := map[string]struct {
+		options  []Option
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
+	}{
+		"contains": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(Contains("name", "es")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%es%\"",
+			expected:    []ObjectB{{Name: "jessica"}},
+		},
+		"contains takes wildcards literally": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(Contains("name", "%")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "100%"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%\\%%\" ESCAPE \"\\\"",
+			expected:    []ObjectB{{Name: "100%"}},
+		},
+		"starts with": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(StartsWith("name", "je")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "aje"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"je%\"",
+			expected:    []ObjectB{{Name: "jessica"}},
+		},
+		"ends with": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(EndsWith("name", "my")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%my\"",
+			expected:    []ObjectB{{Name: "amy"}},
+		},
+		"not contains": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(Contains("name", "es")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` NOT LIKE \"%es%\"",
+			expected:    []ObjectB{{Name: "amy"}},
+		},
+		"case-insensitive contains": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(ILike(Contains("name", "ES"))) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(`name`) LIKE LOWER(\"%ES%\")",
+			expected:    []ObjectB{{Name: "jessica"}},
+		},
+		"contains on cast column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(Contains("age", "2")) },
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE CAST(`age` AS TEXT) LIKE \"%2%\"",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}},
+		},
+		"contains on uuid column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(Contains("id", "4ad1")) },
+			existing:    []ObjectB{{ID: id, Name: "jessica"}, {ID: uuid.MustParse("0b0c6a3e-52c2-4c7e-8f4c-5d3a8d6e2f11"), Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE CAST(`id` AS TEXT) LIKE \"%4ad1%\"",
+			expected:    []ObjectB{{ID: id, Name: "jessica"}},
+		},
+		"contains with plugin": {
+			options:     []Option{WithCharacter("*")},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(Contains("name", "*")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "a*b"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%*%\"",
+			expected:    []ObjectB{{Name: "a*b"}},
+		},
+		"contains with glob": {
+			options:     []Option{SQLiteGlob()},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(Contains("name", "Es*")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "jEs*ica"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` GLOB \"*Es[*]*\"",
+			expected:    []ObjectB{{Name: "jEs*ica"}},
+		},
+		"case-insensitive contains with glob": {
+			options:     []Option{SQLiteGlob()},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(ILike(Contains("name", "ES"))) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(`name`) LIKE LOWER(\"%ES%\")",
+			expected:    []ObjectB{{Name: "jessica"}},
+		},
+		"contains combined with other conditions": {
+			options: []Option{},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Where(Contains("name", "a")).Where(map[string]any{"age": 31})
+			},
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%a%\" AND `age` = 31",
+			expected:    []ObjectB{{Name: "amy", Age: 31}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if testData.options != nil {
+				if err := db.Use(New(testData.options...)); err != nil {
+					t.Error(err)
+					t.FailNow()
+				}
+			}
+
+			// Act
+			var actual []ObjectB
+			err := testData.query(db).Find(&actual).Error
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package gormlike
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// booleanModeOperators have a special meaning in a MySQL full-text search in boolean mode
+const booleanModeOperators = `+-<>()~*"@`
+
+// fullTextCondition returns the full-text search condition for the column, which matches records containing all words
+// of the pattern. It uses to_tsvector and plainto_tsquery on Postgres, MATCH AGAINST on MySQL and FTS5 on SQLite. It
+// returns false if the pattern has no words or the dialect doesn't support full-text search. The table is the FTS5
+// table to search on SQLite, the one of the column is used if it's empty.
+func fullTextCondition(db *gorm.DB, column any, table string, pattern string, negated bool) (string, []any, bool) {
+	words := patternWords(pattern)
+	if len(words) == 0 {
+		return "", nil, false
+	}
+
+	columnExpression := columnSQL(db, column)
+
+	var condition string
+	var query string
+
+	switch db.Dialector.Name() {
+	case "postgres":
+		condition = fmt.Sprintf("to_tsvector(%s) @@ plainto_tsquery(?)", columnExpression)
+		query = strings.Join(words, " ")
+	case "mysql":
+		condition = fmt.Sprintf("MATCH(%s) AGAINST(? IN BOOLEAN MODE)", columnExpression)
+		query = booleanModeQuery(words)
+	case "sqlite":
+		alias, name, _ := splitColumn(column)
+		if alias == "" || alias == clause.CurrentTable {
+			alias = db.Statement.Table
+		}
+
+		// Columns of joins are qualified with an alias like "Company", which isn't a table the subquery can select from
+		if table == "" {
+			table = alias
+		}
+
+		// FTS5 can't MATCH inside of OR and NOT, so the search is done in a subquery on the FTS5 table
+		condition = fmt.Sprintf("%s.rowid IN (SELECT rowid FROM %s WHERE %s MATCH ?)", db.Statement.Quote(alias),
+			db.Statement.Quote(table), db.Statement.Quote(name))
+		query = fts5Query(words)
+	default:
+		return "", nil, false
+	}
+
+	// Words may consist of nothing but operators
+	if query == "" {
+		return "", nil, false
+	}
+
+	// Operators like @@ bind more tightly than NOT, the parentheses are only there for readability
+	if negated {
+		condition = fmt.Sprintf("NOT (%s)", condition)
+	}
+
+	return condition, []any{query}, true
+}
+
+// patternWords returns the words in a LIKE pattern, wildcards separate words just like whitespace does
+func patternWords(pattern string) []string {
+	var text strings.Builder
+
+	for index := 0; index < len(pattern); index++ {
+		switch pattern[index] {
+		case sqlEscapeCharacter[0]:
+			index++
+			if index < len(pattern) {
+				text.WriteByte(pattern[index])
+			}
+		case '%', '_':
+			text.WriteByte(' ')
+		default:
+			text.WriteByte(pattern[index])
+		}
+	}
+
+	return strings.Fields(text.String())
+}
+
+// booleanModeQuery returns a MySQL boolean mode query that requires all words, like plainto_tsquery does. Operators
+// in the words are removed, so that users can't change the meaning of the query.
+func booleanModeQuery(words []string) string {
+	required := make([]string, 0, len(words))
+
+	for _, word := range words {
+		word = strings.Map(func(character rune) rune {
+			if strings.ContainsRune(booleanModeOperators, character) {
+				return -1
+			}
+
+			return character
+		}, word)
+
+		if word != "" {
+			required = append(required, "+"+word)
+		}
+	}
+
+	return strings.Join(required, " ")
+}
+
+// fts5Query returns an FTS5 query that requires all words, each word is quoted so that it's taken literally
+func fts5Query(words []string) string {
+	quoted := make([]string, len(words))
+
+	for index, word := range words {
+		quoted[index] = `"` + strings.ReplaceAll(word, `"`, `""`) + `"`
+	}
+
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,173 @@
+//go:build sqlite_fts5
+
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/ing-bank/gormtestutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// These tests require SQLite with FTS5, run them using `go test -tags sqlite_fts5 ./...`
+func TestGormLike_Initialize_SearchesFullTextOnSQLite(t *testing.T) {
+	t.Parallel()
+
+	type Article struct {
+		Title string `gormlike:"fts"`
+		Body  string `gormlike:"fts"`
+	}
+
+	existing := []Article{
+		{Title: "Gorm plugins", Body: "Writing a plugin for gorm"},
+		{Title: "SQLite full-text search", Body: "Using FTS5 with gorm"},
+		{Title: "Cooking", Body: "A recipe for \"pancakes\""},
+	}
+
+	tests := map[string]struct {
+		query func(*gorm.DB) *gorm.DB
+
+		expected []Article
+	}{
+		"single word": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "%gorm%"}) },
+			expected: []Article{existing[0], existing[1]},
+		},
+		"all words are required": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "%gorm%plugin%"}) },
+			expected: []Article{existing[0]},
+		},
+		"search is limited to the column": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"title": "%gorm%"}) },
+			expected: []Article{existing[0]},
+		},
+		"fts5 syntax is taken literally": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": `%"pancakes* OR%`}) },
+			expected: []Article{},
+		},
+		"fts5 syntax without meaning is ignored": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": `%"pancakes*%`}) },
+			expected: []Article{existing[2]},
+		},
+		"negated": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"body": "%gorm%"}) },
+			expected: []Article{existing[2]},
+		},
+		"multi-value query": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"title": []string{"Cooking", "%sqlite%"}}) },
+			expected: []Article{existing[1], existing[2]},
+		},
+		"no wildcards": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"title": "Cooking"}) },
+			expected: []Article{existing[2]},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			if err := db.Exec("CREATE VIRTUAL TABLE articles USING fts5(title, body)").Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if err := db.CreateInBatches(existing, 10).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			err := db.Use(New())
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []Article
+			err = testData.query(db).Find(&actual).Error
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_SearchesFullTextOfJoinedTableOnSQLite(t *testing.T) {
+	t.Parallel()
+
+	type Company struct {
+		ID   int
+		Name string `gormlike:"fts"`
+	}
+
+	type Employee struct {
+		ID        int
+		Name      string
+		CompanyID int
+		Company   Company
+	}
+
+	companies := []Company{{ID: 1, Name: "Gorm plugins inc"}, {ID: 2, Name: "Pancake factory"}}
+	employees := []Employee{{ID: 1, Name: "Amy", CompanyID: 1}, {ID: 2, Name: "Bob", CompanyID: 2}}
+
+	tests := map[string]struct {
+		query func(*gorm.DB) *gorm.DB
+
+		expected []string
+	}{
+		"joined column": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"Company.name": "%gorm%"}) },
+			expected: []string{"Amy"},
+		},
+		"negated joined column": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"Company.name": "%gorm%"}) },
+			expected: []string{"Bob"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			if err := db.Exec("CREATE VIRTUAL TABLE companies USING fts5(id UNINDEXED, name)").Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if err := db.Exec("CREATE TABLE employees (id INTEGER PRIMARY KEY, name TEXT, company_id INTEGER)").Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if err := db.Omit("Company").CreateInBatches(employees, 10).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			for _, company := range companies {
+				// FTS5 tables have no primary key, so the id is inserted as the rowid as well
+				if err := db.Exec("INSERT INTO companies (rowid, id, name) VALUES (?, ?, ?)", company.ID, company.ID, company.Name).Error; err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// Act
+			err := db.Use(New())
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []Employee
+			err = testData.query(db.Joins("Company")).Order("employees.id").Find(&actual).Error
+
+			assert.NoError(t, err)
+
+			names := make([]string, len(actual))
+			for index, employee := range actual {
+				names[index] = employee.Name
+			}
+
+			assert.Equal(t, testData.expected, names)
+		})
+	}
+}
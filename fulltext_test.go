@@ -0,0 +1,220 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func TestFullTextCondition_ReturnsExpectedCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialector string
+		column    any
+		table     string
+		pattern   string
+		negated   bool
+
+		expectedCondition string
+		expectedVars      []any
+		expectedOk        bool
+	}{
+		"postgres": {
+			dialector:         "postgres",
+			column:            "body",
+			pattern:           "%gorm%plugin%",
+			expectedCondition: "to_tsvector(body) @@ plainto_tsquery(?)",
+			expectedVars:      []any{"gorm plugin"},
+			expectedOk:        true,
+		},
+		"postgres negated": {
+			dialector:         "postgres",
+			column:            "body",
+			pattern:           "%gorm%",
+			negated:           true,
+			expectedCondition: "NOT (to_tsvector(body) @@ plainto_tsquery(?))",
+			expectedVars:      []any{"gorm"},
+			expectedOk:        true,
+		},
+		"postgres with escaped wildcard": {
+			dialector:         "postgres",
+			column:            "body",
+			pattern:           `%100\%%`,
+			expectedCondition: "to_tsvector(body) @@ plainto_tsquery(?)",
+			expectedVars:      []any{"100%"},
+			expectedOk:        true,
+		},
+		"mysql": {
+			dialector:         "mysql",
+			column:            "body",
+			pattern:           "%gorm %plugin%",
+			expectedCondition: "MATCH(body) AGAINST(? IN BOOLEAN MODE)",
+			expectedVars:      []any{"+gorm +plugin"},
+			expectedOk:        true,
+		},
+		"mysql negated": {
+			dialector:         "mysql",
+			column:            "body",
+			pattern:           "%gorm%",
+			negated:           true,
+			expectedCondition: "NOT (MATCH(body) AGAINST(? IN BOOLEAN MODE))",
+			expectedVars:      []any{"+gorm"},
+			expectedOk:        true,
+		},
+		"mysql with only operators": {
+			dialector: "mysql",
+			column:    "body",
+			pattern:   "%-* ()%",
+		},
+		"sqlite": {
+			dialector:         "sqlite",
+			column:            "body",
+			pattern:           `%say "hi"%`,
+			expectedCondition: `"articles".rowid IN (SELECT rowid FROM "articles" WHERE "body" MATCH ?)`,
+			expectedVars:      []any{`"say" """hi"""`},
+			expectedOk:        true,
+		},
+		"sqlite with qualified column": {
+			dialector:         "sqlite",
+			column:            clause.Column{Table: "posts", Name: "body"},
+			pattern:           "%gorm%",
+			expectedCondition: `"posts".rowid IN (SELECT rowid FROM "posts" WHERE "body" MATCH ?)`,
+			expectedVars:      []any{`"gorm"`},
+			expectedOk:        true,
+		},
+		"sqlite with join alias": {
+			dialector:         "sqlite",
+			column:            clause.Column{Table: "Company", Name: "name"},
+			table:             "companies",
+			pattern:           "%gorm%",
+			expectedCondition: `"Company".rowid IN (SELECT rowid FROM "companies" WHERE "name" MATCH ?)`,
+			expectedVars:      []any{`"gorm"`},
+			expectedOk:        true,
+		},
+		"sqlite negated": {
+			dialector:         "sqlite",
+			column:            "body",
+			pattern:           "%gorm%",
+			negated:           true,
+			expectedCondition: `NOT ("articles".rowid IN (SELECT rowid FROM "articles" WHERE "body" MATCH ?))`,
+			expectedVars:      []any{`"gorm"`},
+			expectedOk:        true,
+		},
+		"no words": {
+			dialector: "postgres",
+			column:    "body",
+			pattern:   "% _ %",
+		},
+		"unsupported dialect": {
+			dialector: "sqlserver",
+			column:    "body",
+			pattern:   "%gorm%",
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newTestDB(testData.dialector)
+			db.Statement = &gorm.Statement{DB: db, Table: "articles"}
+
+			// Act
+			condition, vars, ok := fullTextCondition(db, testData.column, testData.table, testData.pattern, testData.negated)
+
+			// Assert
+			assert.Equal(t, testData.expectedOk, ok)
+			assert.Equal(t, testData.expectedCondition, condition)
+			assert.Equal(t, testData.expectedVars, vars)
+		})
+	}
+}
+
+func TestPatternWords_ReturnsExpectedWords(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+
+		expected []string
+	}{
+		"single word":             {pattern: "%gorm%", expected: []string{"gorm"}},
+		"wildcards separate":      {pattern: "%gorm%plugin_like%", expected: []string{"gorm", "plugin", "like"}},
+		"whitespace separates":    {pattern: "%gorm  plugin\t%", expected: []string{"gorm", "plugin"}},
+		"escaped wildcards":       {pattern: `100\%\_a%`, expected: []string{"100%_a"}},
+		"multi-byte characters":   {pattern: "%josé🍌%", expected: []string{"josé🍌"}},
+		"only wildcards":          {pattern: "%_%", expected: []string{}},
+		"empty":                   {pattern: "", expected: []string{}},
+		"trailing escape":         {pattern: `a\`, expected: []string{"a"}},
+		"punctuation is retained": {pattern: `%"hi"%`, expected: []string{`"hi"`}},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := patternWords(testData.pattern)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestBooleanModeQuery_ReturnsExpectedQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		words []string
+
+		expected string
+	}{
+		"words":            {words: []string{"gorm", "plugin"}, expected: "+gorm +plugin"},
+		"operators":        {words: []string{"-gorm*", `"plugin"`, "(a)", "~b", "<c>", "@d"}, expected: "+gorm +plugin +a +b +c +d"},
+		"only operators":   {words: []string{"+", "-"}, expected: ""},
+		"multi-byte words": {words: []string{"josé"}, expected: "+josé"},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := booleanModeQuery(testData.words)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestFts5Query_ReturnsExpectedQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		words []string
+
+		expected string
+	}{
+		"words":     {words: []string{"gorm", "plugin"}, expected: `"gorm" "plugin"`},
+		"operators": {words: []string{"gorm*", "OR", "NEAR(a"}, expected: `"gorm*" "OR" "NEAR(a"`},
+		"quotes":    {words: []string{`say"hi"`}, expected: `"say""hi"""`},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := fts5Query(testData.words)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package gormlike
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultFuzzyThreshold is the similarity fuzzy values need if no threshold was configured, which is the default of
+// pg_trgm.similarity_threshold on Postgres
+const defaultFuzzyThreshold = 0.3
+
+// fuzzyValue returns the search term in the value if it starts with the prefix of WithFuzzyPrefix
+func (d *gormLike) fuzzyValue(value string) (string, bool) {
+	if d.fuzzyPrefix == "" || !strings.HasPrefix(value, d.fuzzyPrefix) || len(value) == len(d.fuzzyPrefix) {
+		return "", false
+	}
+
+	return value[len(d.fuzzyPrefix):], true
+}
+
+// checkFuzzy returns an error if the search term exceeds the limits of the plugin that apply to it, the limits in the
+// tag take precedence
+func (d *gormLike) checkFuzzy(term string, tag likeTag) error {
+	maxLength := d.maxPatternLength
+	if tag.maxLength > 0 {
+		maxLength = tag.maxLength
+	}
+
+	if maxLength > 0 && utf8.RuneCountInString(term) > maxLength {
+		return ErrPatternTooLong
+	}
+
+	// Similar values don't necessarily share a prefix
+	if tag.prefixOnly {
+		return ErrPatternShape
+	}
+
+	return nil
+}
+
+// fieldThreshold returns the fuzzy threshold of the field, which is the one in its tag or the one of the plugin. It
+// returns 0 if neither is configured.
+func (d *gormLike) fieldThreshold(tag likeTag) float64 {
+	if tag.fuzzyThreshold > 0 {
+		return tag.fuzzyThreshold
+	}
+
+	return d.fuzzyThreshold
+}
+
+// fuzzyCondition returns the condition matching values that are similar to the term. Without a threshold, Postgres
+// uses the % operator of pg_trgm, which uses pg_trgm.similarity_threshold and can use a trigram index. Other
+// databases need a similarity function, like the one of the sqlitefunc package.
+func fuzzyCondition(db *gorm.DB, column any, kind likeKind, term string, threshold float64, opts conditionOptions) (string, []any) {
+	columnExpression := comparedColumn(db, columnSQL(db, column), kind, opts)
+	if opts.accentInsensitive {
+		term = Unaccent(term)
+	}
+
+	var condition string
+	var vars []any
+
+	switch {
+	case threshold == 0 && db.Dialector.Name() == "postgres":
+		condition, vars = fmt.Sprintf("%s %% ?", columnExpression), []any{term}
+	case threshold == 0:
+		condition, vars = fmt.Sprintf("similarity(%s, ?) > ?", columnExpression), []any{term, defaultFuzzyThreshold}
+	default:
+		condition, vars = fmt.Sprintf("similarity(%s, ?) > ?", columnExpression), []any{term, threshold}
+	}
+
+	if opts.negated {
+		condition = fmt.Sprintf("NOT (%s)", condition)
+	}
+
+	return condition, vars
+}
+
+// orderBySimilarity orders the results of the statement by their similarity to the term, most similar first. Orders
+// that were already given take precedence, except for the primary key order of First and Last, which only breaks
+// ties. First returns the most similar record and Last the least similar one. Statements that can't be ordered by an
+// expression, like counts, are left alone.
+func orderBySimilarity(db *gorm.DB, column any, kind likeKind, term string, opts conditionOptions) {
+	if !orderable(db) {
+		return
+	}
+
+	columnExpression := comparedColumn(db, columnSQL(db, column), kind, opts)
+	if opts.accentInsensitive {
+		term = Unaccent(term)
+	}
+
+	orderClause := db.Statement.Clauses["ORDER BY"]
+	existing, hasOrder := orderClause.Expression.(clause.OrderBy)
+
+	var similarityOrders []clause.Expression
+	var primaryKey clause.OrderBy
+	var firstOrLast bool
+
+	if hasOrder {
+		similarityOrders, primaryKey, firstOrLast = firstOrLastOrder(existing)
+	}
+
+	direction := "DESC"
+	if firstOrLast && primaryKey.Columns[0].Desc {
+		direction = "ASC"
+	}
+
+	var order clause.Expression = clause.Expr{
+		SQL:  fmt.Sprintf("similarity(%s, ?) %s", columnExpression, direction),
+		Vars: []any{term},
+	}
+
+	// An OrderBy with an expression ignores its columns, so the existing order is made part of the expression
+	switch {
+	case firstOrLast:
+		exprs := make([]clause.Expression, 0, len(similarityOrders)+2)
+		exprs = append(append(exprs, similarityOrders...), order, primaryKey)
+		order = clause.CommaExpression{Exprs: exprs}
+	case hasOrder:
+		order = clause.CommaExpression{Exprs: []clause.Expression{existing, order}}
+	}
+
+	orderClause.Name = "ORDER BY"
+	orderClause.Expression = clause.OrderBy{Expression: order}
+	db.Statement.Clauses["ORDER BY"] = orderClause
+}
+
+// firstOrLastOrder returns the similarity orders in front of the primary key order that First and Last add, and that
+// primary key order itself. It returns false if the order is anything else.
+func firstOrLastOrder(order clause.OrderBy) ([]clause.Expression, clause.OrderBy, bool) {
+	if order.Expression == nil {
+		return nil, order, primaryKeyOrder(order)
+	}
+
+	// Earlier similarity orders were put in front of the primary key order
+	comma, ok := order.Expression.(clause.CommaExpression)
+	if !ok || len(comma.Exprs) < 2 {
+		return nil, clause.OrderBy{}, false
+	}
+
+	last, ok := comma.Exprs[len(comma.Exprs)-1].(clause.OrderBy)
+	if !ok || !primaryKeyOrder(last) {
+		return nil, clause.OrderBy{}, false
+	}
+
+	return comma.Exprs[:len(comma.Exprs)-1], last, true
+}
+
+// primaryKeyOrder returns whether the order is nothing but the primary key order that First and Last add
+func primaryKeyOrder(order clause.OrderBy) bool {
+	if order.Expression != nil || len(order.Columns) != 1 {
+		return false
+	}
+
+	column := order.Columns[0].Column
+
+	return column.Table == clause.CurrentTable && column.Name == clause.PrimaryKey
+}
+
+// orderable returns whether the statement can be ordered by an expression that isn't selected, which isn't the case
+// for counts, grouped and distinct queries
+func orderable(db *gorm.DB) bool {
+	if _, ok := db.Statement.Clauses["GROUP BY"]; ok || db.Statement.Distinct {
+		return false
+	}
+
+	// Count selects count(*) using a clause.Select with an expression, which stores just the expression in the clause
+	expression := db.Statement.Clauses["SELECT"].Expression
+	if selectClause, ok := expression.(clause.Select); ok {
+		expression = selectClause.Expression
+	}
+
+	if expression, ok := expression.(clause.Expr); ok {
+		return !strings.HasPrefix(strings.ToLower(expression.SQL), "count(")
+	}
+
+	return true
+}
+
+// Similarity returns how similar the values are as a number between 0 and 1, using the trigrams of their words like
+// pg_trgm does on Postgres. It's used by the sqlitefunc package to provide similarity() on SQLite.
+func Similarity(first string, second string) float64 {
+	firstTrigrams := trigrams(first)
+	secondTrigrams := trigrams(second)
+
+	var shared int
+
+	for trigram := range firstTrigrams {
+		if _, ok := secondTrigrams[trigram]; ok {
+			shared++
+		}
+	}
+
+	total := len(firstTrigrams) + len(secondTrigrams) - shared
+	if total == 0 {
+		return 0
+	}
+
+	return float64(shared) / float64(total)
+}
+
+// trigrams returns the unique trigrams of all words in the value, words are lowercased and padded with two spaces in
+// front and one space at the end
+func trigrams(value string) map[string]struct{} {
+	result := map[string]struct{}{}
+
+	words := strings.FieldsFunc(strings.ToLower(value), func(character rune) bool {
+		return !unicode.IsLetter(character) && !unicode.IsDigit(character)
+	})
+
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+
+		for index := 0; index+3 <= len(padded); index++ {
+			result[string(padded[index:index+3])] = struct{}{}
+		}
+	}
+
+	return result
+}
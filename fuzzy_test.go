@@ -0,0 +1,337 @@
+package gormlike
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+func TestGormLike_FuzzyValue_ReturnsExpectedTerm(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		options []Option
+
+		expectedTerm string
+		expectedOk   bool
+	}{
+		"no prefix configured": {
+			value: "~jonh",
+		},
+		"fuzzy value": {
+			value:        "~jonh",
+			options:      []Option{WithFuzzyPrefix("~")},
+			expectedTerm: "jonh",
+			expectedOk:   true,
+		},
+		"multi-character prefix": {
+			value:        "fuzzy:jonh",
+			options:      []Option{WithFuzzyPrefix("fuzzy:")},
+			expectedTerm: "jonh",
+			expectedOk:   true,
+		},
+		"prefix inside value": {
+			value:   "jo~nh",
+			options: []Option{WithFuzzyPrefix("~")},
+		},
+		"lone prefix": {
+			value:   "~",
+			options: []Option{WithFuzzyPrefix("~")},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			// Act
+			term, ok := plugin.fuzzyValue(testData.value)
+
+			// Assert
+			assert.Equal(t, testData.expectedOk, ok)
+			assert.Equal(t, testData.expectedTerm, term)
+		})
+	}
+}
+
+func TestGormLike_CheckFuzzy_ReturnsExpectedError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		term    string
+		options []Option
+		tag     likeTag
+
+		expected error
+	}{
+		"no limits": {
+			term: "jonh",
+		},
+		"within length limit": {
+			term:    "jonh",
+			options: []Option{WithMaxPatternLength(4)},
+		},
+		"too long": {
+			term:     "jonathan",
+			options:  []Option{WithMaxPatternLength(4)},
+			expected: ErrPatternTooLong,
+		},
+		"too long for tag": {
+			term:     "jonathan",
+			tag:      likeTag{maxLength: 4},
+			expected: ErrPatternTooLong,
+		},
+		"prefix-only tag": {
+			term:     "jonh",
+			tag:      likeTag{prefixOnly: true},
+			expected: ErrPatternShape,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			// Act
+			err := plugin.checkFuzzy(testData.term, testData.tag)
+
+			// Assert
+			assert.Equal(t, testData.expected, err)
+		})
+	}
+}
+
+func TestGormLike_FieldThreshold_ReturnsExpectedThreshold(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		options []Option
+		tag     likeTag
+
+		expected float64
+	}{
+		"not configured":       {expected: 0},
+		"plugin threshold":     {options: []Option{WithFuzzyThreshold(0.4)}, expected: 0.4},
+		"tag threshold":        {tag: likeTag{fuzzyThreshold: 0.6}, expected: 0.6},
+		"tag takes precedence": {options: []Option{WithFuzzyThreshold(0.4)}, tag: likeTag{fuzzyThreshold: 0.6}, expected: 0.6},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			// Act
+			result := plugin.fieldThreshold(testData.tag)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestFuzzyCondition_ReturnsExpectedCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialector string
+		field     *schema.Field
+		threshold float64
+		opts      conditionOptions
+
+		expectedCondition string
+		expectedVars      []any
+	}{
+		"postgres": {
+			dialector:         "postgres",
+			expectedCondition: "name % ?",
+			expectedVars:      []any{"jonh"},
+		},
+		"postgres with threshold": {
+			dialector:         "postgres",
+			threshold:         0.5,
+			expectedCondition: "similarity(name, ?) > ?",
+			expectedVars:      []any{"jonh", 0.5},
+		},
+		"postgres negated": {
+			dialector:         "postgres",
+			opts:              conditionOptions{negated: true},
+			expectedCondition: "NOT (name % ?)",
+			expectedVars:      []any{"jonh"},
+		},
+		"postgres accent-insensitive": {
+			dialector:         "postgres",
+			opts:              conditionOptions{accentInsensitive: true},
+			expectedCondition: "unaccent(name) % ?",
+			expectedVars:      []any{"jonh"},
+		},
+		"sqlite": {
+			dialector:         "sqlite",
+			expectedCondition: "similarity(name, ?) > ?",
+			expectedVars:      []any{"jonh", 0.3},
+		},
+		"sqlite with threshold": {
+			dialector:         "sqlite",
+			threshold:         0.7,
+			expectedCondition: "similarity(name, ?) > ?",
+			expectedVars:      []any{"jonh", 0.7},
+		},
+		"sqlite with cast": {
+			dialector:         "sqlite",
+			field:             &schema.Field{DBName: "name", DataType: schema.Int, FieldType: reflect.TypeOf(0)},
+			expectedCondition: "similarity(CAST(name AS TEXT), ?) > ?",
+			expectedVars:      []any{"jonh", 0.3},
+		},
+		"case-insensitive is ignored": {
+			dialector:         "sqlite",
+			opts:              conditionOptions{caseInsensitive: true},
+			expectedCondition: "similarity(name, ?) > ?",
+			expectedVars:      []any{"jonh", 0.3},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newTestDB(testData.dialector)
+
+			field := testData.field
+			if field == nil {
+				field = &schema.Field{DBName: "name", DataType: schema.String, FieldType: reflect.TypeOf("")}
+			}
+
+			// Act
+			condition, vars := fuzzyCondition(db, "name", fieldKind(field), "jonh", testData.threshold, testData.opts)
+
+			// Assert
+			assert.Equal(t, testData.expectedCondition, condition)
+			assert.Equal(t, testData.expectedVars, vars)
+		})
+	}
+}
+
+func TestOrderBySimilarity_ReturnsExpectedOrder(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		ID   int
+		Name string
+	}
+
+	primaryKey := clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey}
+
+	tests := map[string]struct {
+		existing *clause.OrderBy
+		terms    []string
+
+		expected string
+	}{
+		"no existing order": {
+			terms:    []string{"jonh"},
+			expected: `ORDER BY similarity(name, ?) DESC`,
+		},
+		"existing order": {
+			existing: &clause.OrderBy{Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "age"}}}},
+			terms:    []string{"jonh"},
+			expected: `ORDER BY "age", similarity(name, ?) DESC`,
+		},
+		"first": {
+			existing: &clause.OrderBy{Columns: []clause.OrderByColumn{{Column: primaryKey}}},
+			terms:    []string{"jonh"},
+			expected: `ORDER BY similarity(name, ?) DESC, "object_as"."id"`,
+		},
+		"last": {
+			existing: &clause.OrderBy{Columns: []clause.OrderByColumn{{Column: primaryKey, Desc: true}}},
+			terms:    []string{"jonh"},
+			expected: `ORDER BY similarity(name, ?) ASC, "object_as"."id" DESC`,
+		},
+		"first with multiple terms": {
+			existing: &clause.OrderBy{Columns: []clause.OrderByColumn{{Column: primaryKey}}},
+			terms:    []string{"jonh", "amy"},
+			expected: `ORDER BY similarity(name, ?) DESC, similarity(name, ?) DESC, "object_as"."id"`,
+		},
+		"first with existing order": {
+			existing: &clause.OrderBy{Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "age"}}, {Column: primaryKey}}},
+			terms:    []string{"jonh"},
+			expected: `ORDER BY "age","object_as"."id", similarity(name, ?) DESC`,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			objectSchema, err := schema.Parse(&ObjectA{}, &sync.Map{}, schema.NamingStrategy{})
+			if err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			db := newTestDB("sqlite")
+			db.Statement = &gorm.Statement{DB: db, Table: objectSchema.Table, Schema: objectSchema, Clauses: map[string]clause.Clause{}}
+
+			if testData.existing != nil {
+				db.Statement.AddClause(*testData.existing)
+			}
+
+			// Act
+			for _, term := range testData.terms {
+				orderBySimilarity(db, "name", textField, term, conditionOptions{})
+			}
+
+			// Assert
+			db.Statement.Build("ORDER BY")
+			assert.Equal(t, testData.expected, db.Statement.SQL.String())
+		})
+	}
+}
+
+func TestSimilarity_ReturnsExpectedSimilarity(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		first  string
+		second string
+
+		expected float64
+	}{
+		"identical":             {first: "john", second: "john", expected: 1},
+		"different case":        {first: "John", second: "jOHN", expected: 1},
+		"transposed characters": {first: "jonh", second: "john", expected: 0.25},
+		"nothing in common":     {first: "john", second: "amy", expected: 0},
+		"multiple words":        {first: "word", second: "two words", expected: 4.0 / 11},
+		"punctuation":           {first: "john-smith", second: "john smith", expected: 1},
+		"multi-byte characters": {first: "josé", second: "josé", expected: 1},
+		"empty":                 {first: "", second: "", expected: 0},
+		"one empty":             {first: "john", second: "", expected: 0},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := Similarity(testData.first, testData.second)
+
+			// Assert
+			assert.InDelta(t, testData.expected, result, 0.0001)
+		})
+	}
+}
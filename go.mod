@@ -5,7 +5,9 @@ go 1.22.4
 require (
 	github.com/google/uuid v1.6.0
 	github.com/ing-bank/gormtestutil v0.0.1
+	github.com/mattn/go-sqlite3 v1.14.24
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/text v0.22.0
 	gorm.io/driver/sqlite v1.4.4
 	gorm.io/gorm v1.24.2
 )
@@ -15,7 +17,6 @@ require (
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/kr/text v0.2.0 // indirect
-	github.com/mattn/go-sqlite3 v1.14.24 // indirect
 	github.com/mitchellh/go-testing-interface v1.14.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,83 @@
+package gormlike
+
+import (
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+// redactedValue is logged in place of filter values, unless WithValueLogging is given
+const redactedValue = "[REDACTED]"
+
+// Reasons given in the logs when a query or condition is not turned into a LIKE query
+const (
+	reasonSettingDisabled = "disabled by setting"
+	reasonSettingMissing  = "setting required"
+	reasonTagDisabled     = "disabled by tag"
+	reasonTagMissing      = "tag required"
+	reasonNoStringColumn  = "unsupported column type"
+	reasonNoStringValue   = "value is not a string"
+	reasonNotLikeable     = "field type can't be LIKE-d"
+	reasonUnknownField    = "unknown field"
+	reasonInvalidTag      = "invalid tag"
+	reasonFieldNotAllowed = "not allowed by options"
+	reasonNoWildcards     = "no wildcards found"
+)
+
+// logRewrite reports a condition that was turned into a LIKE query
+func (d *gormLike) logRewrite(db *gorm.DB, column string, value string, condition string) {
+	if d.logger == nil {
+		return
+	}
+
+	d.logger.DebugContext(db.Statement.Context, "gormlike: rewrote condition",
+		slog.String("column", column),
+		d.valueAttr(value),
+		slog.String("sql", condition),
+	)
+}
+
+// logQuerySkip reports a query that was left untouched entirely and why
+func (d *gormLike) logQuerySkip(db *gorm.DB, reason string) {
+	if d.logger == nil {
+		return
+	}
+
+	d.logger.DebugContext(db.Statement.Context, "gormlike: skipped query", slog.String("reason", reason))
+}
+
+// logSkip reports a condition that was left untouched and why
+func (d *gormLike) logSkip(db *gorm.DB, column string, value any, reason string) {
+	if d.logger == nil {
+		return
+	}
+
+	d.logger.DebugContext(db.Statement.Context, "gormlike: skipped condition",
+		slog.String("column", column),
+		d.valueAttr(value),
+		slog.String("reason", reason),
+	)
+}
+
+// logReject reports a pattern that exceeded the limits of the plugin
+func (d *gormLike) logReject(db *gorm.DB, column string, value any, err error) {
+	if d.logger == nil {
+		return
+	}
+
+	d.logger.WarnContext(db.Statement.Context, "gormlike: rejected pattern",
+		slog.String("column", column),
+		d.valueAttr(value),
+		slog.String("reason", err.Error()),
+		slog.Bool("degraded", d.degradeRejected),
+	)
+}
+
+// valueAttr returns the value as a log attribute, redacted by default to prevent leaking filter values
+func (d *gormLike) valueAttr(value any) slog.Attr {
+	if !d.logValues {
+		return slog.String("value", redactedValue)
+	}
+
+	return slog.Any("value", value)
+}
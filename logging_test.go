@@ -0,0 +1,90 @@
+package gormlike
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/ing-bank/gormtestutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGormLike_Initialize_LogsRewriteDecisions(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string `gormlike:"false"`
+	}
+
+	tests := map[string]struct {
+		filter  map[string]any
+		query   func(*gorm.DB) *gorm.DB
+		options []Option
+
+		expected    []string
+		notExpected []string
+	}{
+		"rewritten condition with redacted value": {
+			filter:      map[string]any{"name": "%jes%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			expected:    []string{`"msg":"gormlike: rewrote condition"`, `"column":"name"`, `"value":"[REDACTED]"`, `"sql":"name LIKE ?"`},
+			notExpected: []string{"%jes%"},
+		},
+		"rewritten condition with value": {
+			filter:   map[string]any{"name": "%jes%"},
+			query:    func(db *gorm.DB) *gorm.DB { return db },
+			options:  []Option{WithValueLogging()},
+			expected: []string{`"msg":"gormlike: rewrote condition"`, `"column":"name"`, `"value":"%jes%"`},
+		},
+		"skipped condition due to tag": {
+			filter:      map[string]any{"other": "%jes%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			expected:    []string{`"msg":"gormlike: skipped condition"`, `"column":"other"`, `"reason":"disabled by tag"`},
+			notExpected: []string{"%jes%"},
+		},
+		"skipped condition without wildcards": {
+			filter:   map[string]any{"name": []string{"jessica", "amy"}},
+			query:    func(db *gorm.DB) *gorm.DB { return db },
+			expected: []string{`"msg":"gormlike: skipped condition"`, `"column":"name"`, `"reason":"no wildcards found"`},
+		},
+		"skipped query due to setting": {
+			filter:   map[string]any{"name": "%jes%"},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Set(tagName, false) },
+			expected: []string{`"msg":"gormlike: skipped query"`, `"reason":"disabled by setting"`},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			output := new(bytes.Buffer)
+			logger := slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			plugin := New(append(testData.options, WithLogger(logger))...)
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = testData.query(db).Where(testData.filter).Find(&actual).Error
+			assert.NoError(t, err)
+
+			for _, expected := range testData.expected {
+				assert.Contains(t, output.String(), expected)
+			}
+
+			for _, notExpected := range testData.notExpected {
+				assert.NotContains(t, output.String(), notExpected)
+			}
+		})
+	}
+}
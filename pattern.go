@@ -0,0 +1,212 @@
+package gormlike
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// sqlEscapeCharacter is used in the ESCAPE clause of generated LIKE conditions
+const sqlEscapeCharacter = `\`
+
+// The range of UTF-16 surrogate halves, which can't be encoded in UTF-8
+const (
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+)
+
+// convertValue turns a user-provided value into a LIKE pattern. Wildcards are %, the given replacement character and the
+// single-character replacement, every other character is taken literally and escaped if SQL would otherwise see it as
+// a wildcard. Characters prefixed with the escape character are always taken literally.
+//
+// It returns the pattern, the value without escape characters and the amount of wildcards that were found.
+func (d *gormLike) convertValue(value string, replaceCharacter string) (string, string, int) {
+	var pattern, literal strings.Builder
+	var wildcards int
+
+	for len(value) > 0 {
+		switch {
+		case d.escapeCharacter != "" && strings.HasPrefix(value, d.escapeCharacter):
+			value = value[len(d.escapeCharacter):]
+
+			// A trailing escape character has nothing to escape and is taken literally
+			next := d.escapeCharacter
+			if len(value) > 0 {
+				next = firstCharacter(value)
+			}
+
+			pattern.WriteString(escapeLike(next))
+			literal.WriteString(next)
+			value = value[min(len(next), len(value)):]
+
+		case replaceCharacter != "" && strings.HasPrefix(value, replaceCharacter):
+			pattern.WriteByte('%')
+			wildcards++
+			value = value[len(replaceCharacter):]
+
+		case d.singleCharacter != "" && strings.HasPrefix(value, d.singleCharacter):
+			pattern.WriteByte('_')
+			wildcards++
+			value = value[len(d.singleCharacter):]
+
+		case value[0] == '%':
+			pattern.WriteByte('%')
+			wildcards++
+			value = value[1:]
+
+		default:
+			next := firstCharacter(value)
+			pattern.WriteString(escapeLike(next))
+			literal.WriteString(next)
+			value = value[len(next):]
+		}
+	}
+
+	return pattern.String(), literal.String(), wildcards
+}
+
+// checkPattern returns an error if the pattern exceeds the limits of the plugin, the limits in the tag take precedence
+func (d *gormLike) checkPattern(value string, pattern string, wildcards int, tag likeTag) error {
+	maxLength := d.maxPatternLength
+	if tag.maxLength > 0 {
+		maxLength = tag.maxLength
+	}
+
+	if maxLength > 0 && utf8.RuneCountInString(value) > maxLength {
+		return ErrPatternTooLong
+	}
+
+	maxWildcards := d.maxWildcards
+	if tag.maxWildcards > 0 {
+		maxWildcards = tag.maxWildcards
+	}
+
+	if maxWildcards > 0 && wildcards > maxWildcards {
+		return ErrTooManyWildcards
+	}
+
+	if (d.forbidLeadingWildcard || tag.forbidLeadingWildcard) && (pattern[0] == '%' || pattern[0] == '_') {
+		return ErrLeadingWildcard
+	}
+
+	if _, ok := patternPrefix(pattern); tag.prefixOnly && !ok {
+		return ErrPatternShape
+	}
+
+	return nil
+}
+
+// patternPrefix returns the literal prefix of a pattern that consists of a prefix followed by a single %, like
+// "abc%". It returns false for all other patterns, including a lone %.
+func patternPrefix(pattern string) (string, bool) {
+	var prefix strings.Builder
+
+	for index := 0; index < len(pattern); index++ {
+		switch pattern[index] {
+		case sqlEscapeCharacter[0]:
+			index++
+			if index < len(pattern) {
+				prefix.WriteByte(pattern[index])
+			}
+		case '%':
+			return prefix.String(), index == len(pattern)-1 && index > 0
+		case '_':
+			return "", false
+		default:
+			prefix.WriteByte(pattern[index])
+		}
+	}
+
+	return "", false
+}
+
+// prefixUpperBound returns the smallest string that's larger than all strings starting with the prefix, by
+// incrementing its last character. Characters that can't be incremented are dropped, if none can be incremented it
+// returns false.
+func prefixUpperBound(prefix string) (string, bool) {
+	runes := []rune(prefix)
+
+	for index := len(runes) - 1; index >= 0; index-- {
+		next := runes[index] + 1
+
+		// Surrogate halves aren't valid characters in UTF-8
+		if next >= surrogateMin && next <= surrogateMax {
+			next = surrogateMax + 1
+		}
+
+		if next <= utf8.MaxRune {
+			runes[index] = next
+			return string(runes[:index+1]), true
+		}
+	}
+
+	return "", false
+}
+
+// globPattern turns a LIKE pattern into a GLOB pattern for SQLite. GLOB has no escape character, so characters with a
+// special meaning in GLOB are wrapped in a character class instead.
+func globPattern(pattern string) string {
+	var glob strings.Builder
+
+	for index := 0; index < len(pattern); index++ {
+		switch character := pattern[index]; character {
+		case sqlEscapeCharacter[0]:
+			index++
+			if index < len(pattern) {
+				writeGlobLiteral(&glob, pattern[index])
+			}
+		case '%':
+			glob.WriteByte('*')
+		case '_':
+			glob.WriteByte('?')
+		default:
+			writeGlobLiteral(&glob, character)
+		}
+	}
+
+	return glob.String()
+}
+
+// writeGlobLiteral writes the byte to the GLOB pattern so that it's taken literally
+func writeGlobLiteral(glob *strings.Builder, character byte) {
+	switch character {
+	case '*', '?', '[':
+		glob.WriteByte('[')
+		glob.WriteByte(character)
+		glob.WriteByte(']')
+	default:
+		glob.WriteByte(character)
+	}
+}
+
+// literalEscaper escapes all characters that have a special meaning in a LIKE pattern
+var literalEscaper = strings.NewReplacer(
+	sqlEscapeCharacter, sqlEscapeCharacter+sqlEscapeCharacter,
+	"%", sqlEscapeCharacter+"%",
+	"_", sqlEscapeCharacter+"_",
+)
+
+// escapeLiteral turns the value into a pattern that matches it literally
+func escapeLiteral(value string) string {
+	return literalEscaper.Replace(value)
+}
+
+// escapeLike escapes all characters that have a special meaning in a LIKE pattern
+func escapeLike(value string) string {
+	switch value {
+	case "%", "_", sqlEscapeCharacter:
+		return sqlEscapeCharacter + value
+	default:
+		return value
+	}
+}
+
+// firstCharacter returns the first (possibly multi-byte) character of the value
+func firstCharacter(value string) string {
+	for index := range value {
+		if index > 0 {
+			return value[:index]
+		}
+	}
+
+	return value
+}
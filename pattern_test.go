@@ -0,0 +1,332 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGormLike_ConvertValue_ReturnsExpectedPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		options []Option
+
+		expectedPattern   string
+		expectedLiteral   string
+		expectedWildcards int
+	}{
+		"empty": {
+			value:           "",
+			expectedPattern: "",
+			expectedLiteral: "",
+		},
+		"no wildcards": {
+			value:           "jessica",
+			expectedPattern: "jessica",
+			expectedLiteral: "jessica",
+		},
+		"wildcards": {
+			value:             "%jes%",
+			expectedPattern:   "%jes%",
+			expectedLiteral:   "jes",
+			expectedWildcards: 2,
+		},
+		"underscores are escaped": {
+			value:             "%j_s%",
+			expectedPattern:   `%j\_s%`,
+			expectedLiteral:   "j_s",
+			expectedWildcards: 2,
+		},
+		"backslashes are escaped": {
+			value:             `%j\s%`,
+			expectedPattern:   `%j\\s%`,
+			expectedLiteral:   `j\s`,
+			expectedWildcards: 2,
+		},
+		"replacement character": {
+			value:             "*jes🍌",
+			options:           []Option{WithCharacter("*")},
+			expectedPattern:   "%jes🍌",
+			expectedLiteral:   "jes🍌",
+			expectedWildcards: 1,
+		},
+		"single-character replacement": {
+			value:             "j?s_",
+			options:           []Option{WithSingleCharacter("?")},
+			expectedPattern:   `j_s\_`,
+			expectedLiteral:   "js_",
+			expectedWildcards: 1,
+		},
+		"single-character and replacement character": {
+			value:             "?e*",
+			options:           []Option{WithCharacter("*"), WithSingleCharacter("?")},
+			expectedPattern:   "_e%",
+			expectedLiteral:   "e",
+			expectedWildcards: 2,
+		},
+		"escaped single-character replacement": {
+			value:           "why!?",
+			options:         []Option{WithSingleCharacter("?"), WithEscapeCharacter("!")},
+			expectedPattern: "why?",
+			expectedLiteral: "why?",
+		},
+		"escaped percentage": {
+			value:             `!%jes%`,
+			options:           []Option{WithEscapeCharacter("!")},
+			expectedPattern:   `\%jes%`,
+			expectedLiteral:   "%jes",
+			expectedWildcards: 1,
+		},
+		"only escaped percentage": {
+			value:           `100!%`,
+			options:         []Option{WithEscapeCharacter("!")},
+			expectedPattern: `100\%`,
+			expectedLiteral: "100%",
+		},
+		"escaped replacement character": {
+			value:           `🍌🍓🍌`,
+			options:         []Option{WithCharacter("🍓"), WithEscapeCharacter("🍌")},
+			expectedPattern: `🍓🍌`,
+			expectedLiteral: "🍓🍌",
+		},
+		"escaped escape character": {
+			value:             `a\\%`,
+			options:           []Option{WithEscapeCharacter(`\`)},
+			expectedPattern:   `a\\%`,
+			expectedLiteral:   `a\`,
+			expectedWildcards: 1,
+		},
+		"trailing escape character": {
+			value:           `a!`,
+			options:         []Option{WithEscapeCharacter("!")},
+			expectedPattern: `a!`,
+			expectedLiteral: `a!`,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			// Act
+			pattern, literal, wildcards := plugin.convertValue(testData.value, plugin.replaceCharacter)
+
+			// Assert
+			assert.Equal(t, testData.expectedPattern, pattern)
+			assert.Equal(t, testData.expectedLiteral, literal)
+			assert.Equal(t, testData.expectedWildcards, wildcards)
+		})
+	}
+}
+
+func TestGormLike_CheckPattern_ReturnsExpectedError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		options []Option
+		tag     likeTag
+
+		expected error
+	}{
+		"no limits": {
+			value: "%%%%a%%%%",
+		},
+		"within wildcard limit": {
+			value:   "a%b%",
+			options: []Option{WithMaxWildcards(2)},
+		},
+		"too many wildcards": {
+			value:    "a%b%c%",
+			options:  []Option{WithMaxWildcards(2)},
+			expected: ErrTooManyWildcards,
+		},
+		"too many wildcards for tag": {
+			value:    "a%b%",
+			options:  []Option{WithMaxWildcards(2)},
+			tag:      likeTag{maxWildcards: 1},
+			expected: ErrTooManyWildcards,
+		},
+		"within wildcard limit of tag": {
+			value:   "a%b%c%",
+			options: []Option{WithMaxWildcards(2)},
+			tag:     likeTag{maxWildcards: 3},
+		},
+		"within length limit": {
+			value:   "🍌🍌%",
+			options: []Option{WithMaxPatternLength(3)},
+		},
+		"too long": {
+			value:    "abc%",
+			options:  []Option{WithMaxPatternLength(3)},
+			expected: ErrPatternTooLong,
+		},
+		"too long for tag": {
+			value:    "abc%",
+			tag:      likeTag{maxLength: 3},
+			expected: ErrPatternTooLong,
+		},
+		"leading wildcard": {
+			value:    "%a",
+			options:  []Option{WithForbidLeadingWildcard()},
+			expected: ErrLeadingWildcard,
+		},
+		"leading single-character wildcard": {
+			value:    "?a",
+			options:  []Option{WithSingleCharacter("?"), WithForbidLeadingWildcard()},
+			expected: ErrLeadingWildcard,
+		},
+		"leading wildcard for tag": {
+			value:    "%a",
+			tag:      likeTag{forbidLeadingWildcard: true},
+			expected: ErrLeadingWildcard,
+		},
+		"trailing wildcard": {
+			value:   "a%",
+			options: []Option{WithForbidLeadingWildcard()},
+		},
+		"prefix pattern for prefix-only tag": {
+			value: "ab%",
+			tag:   likeTag{prefixOnly: true},
+		},
+		"suffix pattern for prefix-only tag": {
+			value:    "%ab",
+			tag:      likeTag{prefixOnly: true},
+			expected: ErrPatternShape,
+		},
+		"infix pattern for prefix-only tag": {
+			value:    "a%b",
+			tag:      likeTag{prefixOnly: true},
+			expected: ErrPatternShape,
+		},
+		"multiple trailing wildcards for prefix-only tag": {
+			value:    "ab%%",
+			tag:      likeTag{prefixOnly: true},
+			expected: ErrPatternShape,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+			pattern, _, wildcards := plugin.convertValue(testData.value, plugin.replaceCharacter)
+
+			// Act
+			err := plugin.checkPattern(testData.value, pattern, wildcards, testData.tag)
+
+			// Assert
+			assert.Equal(t, testData.expected, err)
+		})
+	}
+}
+
+func TestPatternPrefix_ReturnsExpectedPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+
+		expectedPrefix string
+		expectedOk     bool
+	}{
+		"prefix":              {pattern: "abc%", expectedPrefix: "abc", expectedOk: true},
+		"multi-byte prefix":   {pattern: "🍌é%", expectedPrefix: "🍌é", expectedOk: true},
+		"escaped prefix":      {pattern: `a\%\_\\%`, expectedPrefix: `a%_\`, expectedOk: true},
+		"lone wildcard":       {pattern: "%"},
+		"suffix":              {pattern: "%abc"},
+		"contains":            {pattern: "%abc%"},
+		"multiple wildcards":  {pattern: "ab%c%"},
+		"single-character":    {pattern: "ab_%"},
+		"escaped wildcard":    {pattern: `abc\%`},
+		"no wildcards at all": {pattern: "abc"},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			prefix, ok := patternPrefix(testData.pattern)
+
+			// Assert
+			assert.Equal(t, testData.expectedOk, ok)
+			if testData.expectedOk {
+				assert.Equal(t, testData.expectedPrefix, prefix)
+			}
+		})
+	}
+}
+
+func TestPrefixUpperBound_ReturnsExpectedBound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prefix string
+
+		expectedBound string
+		expectedOk    bool
+	}{
+		"ascii":             {prefix: "abc", expectedBound: "abd", expectedOk: true},
+		"ascii boundary":    {prefix: "az", expectedBound: "a{", expectedOk: true},
+		"multi-byte":        {prefix: "aé", expectedBound: "aê", expectedOk: true},
+		"emoji":             {prefix: "a🍌", expectedBound: "a🍍", expectedOk: true},
+		"before surrogates": {prefix: "a퟿", expectedBound: "a", expectedOk: true},
+		"maximum character": {prefix: "a\U0010FFFF", expectedBound: "b", expectedOk: true},
+		"only maximum":      {prefix: "\U0010FFFF\U0010FFFF"},
+		"empty":             {prefix: ""},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			bound, ok := prefixUpperBound(testData.prefix)
+
+			// Assert
+			assert.Equal(t, testData.expectedOk, ok)
+			assert.Equal(t, testData.expectedBound, bound)
+		})
+	}
+}
+
+func TestGlobPattern_ReturnsExpectedPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+
+		expected string
+	}{
+		"no wildcards":            {pattern: "abc", expected: "abc"},
+		"wildcards":               {pattern: "%a_c%", expected: "*a?c*"},
+		"escaped wildcards":       {pattern: `100\%\_`, expected: "100%_"},
+		"escaped escape":          {pattern: `a\\%`, expected: `a\*`},
+		"glob characters":         {pattern: "a*b?c[d]%", expected: "a[*]b[?]c[[]d]*"},
+		"multi-byte characters":   {pattern: "🍌é%", expected: "🍌é*"},
+		"trailing escape":         {pattern: `a\`, expected: "a"},
+		"empty":                   {pattern: "", expected: ""},
+		"only glob character":     {pattern: "[", expected: "[[]"},
+		"closing bracket is kept": {pattern: "]%", expected: "]*"},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := globPattern(testData.pattern)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package gormlike
 
 import (
+	"log/slog"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 // Compile-time interface check
@@ -17,6 +20,58 @@ func WithCharacter(character string) Option {
 	}
 }
 
+// WithSingleCharacter allows you to specify a replacement character for the _ in the LIKE queries, which matches exactly
+// one character. Without it, underscores are taken literally.
+func WithSingleCharacter(character string) Option {
+	return func(like *gormLike) {
+		like.singleCharacter = character
+	}
+}
+
+// WithEscapeCharacter allows you to specify a character that makes the next character in a value literal, so that
+// users can search for a literal % or replacement character.
+func WithEscapeCharacter(character string) Option {
+	return func(like *gormLike) {
+		like.escapeCharacter = character
+	}
+}
+
+// WithRegexDelimiter turns values wrapped in the delimiter, like "/^ab.*z$/", into regular expression conditions. These
+// use ~ on Postgres and REGEXP on MySQL, SQLite requires the regexp function of the sqlitefunc package. Expressions are
+// validated in Go and rejected with ErrInvalidRegex or ErrUnsafeRegex, so they should use syntax supported by both Go
+// and your database. WithMaxPatternLength applies to them and WithForbidLeadingWildcard requires them to start with ^.
+func WithRegexDelimiter(delimiter string) Option {
+	return func(like *gormLike) {
+		like.regexDelimiter = delimiter
+	}
+}
+
+// WithFuzzyPrefix turns values starting with the prefix, like "~jonh", into conditions matching similar values. These
+// use the similarity function of pg_trgm on Postgres, which requires `CREATE EXTENSION pg_trgm`, and SQLite requires
+// the similarity function of the sqlitefunc package. Other databases need a similarity function of their own.
+func WithFuzzyPrefix(prefix string) Option {
+	return func(like *gormLike) {
+		like.fuzzyPrefix = prefix
+	}
+}
+
+// WithFuzzyThreshold sets the similarity between 0 and 1 that values need to match a fuzzy value. By default, Postgres
+// uses pg_trgm.similarity_threshold and other databases use 0.3. Fields can override it using the fuzzy=0.5 tag.
+func WithFuzzyThreshold(threshold float64) Option {
+	return func(like *gormLike) {
+		like.fuzzyThreshold = threshold
+	}
+}
+
+// OrderByFuzzySimilarity orders the results of queries with a fuzzy value by their similarity to it, most similar
+// first. Orders given using db.Order(...) take precedence. First returns the most similar record and Last the least
+// similar one.
+func OrderByFuzzySimilarity() Option {
+	return func(like *gormLike) {
+		like.fuzzyOrder = true
+	}
+}
+
 // TaggedOnly makes it so that only fields with the tag `gormlike` can be turned into LIKE queries,
 // useful if you don't want every field to be LIKE-able.
 func TaggedOnly() Option {
@@ -25,6 +80,34 @@ func TaggedOnly() Option {
 	}
 }
 
+// WithFields makes only the given columns like-able, for models whose tags can't be changed. Columns are given by name
+// and may be qualified with the table or model, like "email", "users.email" or "User.Email". Like with TaggedOnly,
+// fields with the `gormlike:"true"` tag remain like-able. It can be given multiple times.
+func WithFields(fields ...string) Option {
+	return WithFieldsFunc(fieldNamed(fields))
+}
+
+// WithFieldsFunc makes only the fields for which the predicate returns true like-able, like WithFields.
+func WithFieldsFunc(predicate func(*schema.Field) bool) Option {
+	return func(like *gormLike) {
+		like.includedFields = append(like.includedFields, predicate)
+	}
+}
+
+// WithoutFields prevents the given columns from ever being LIKE-d, for models whose tags can't be changed. Columns are
+// given like in WithFields. It can be given multiple times.
+func WithoutFields(fields ...string) Option {
+	return WithoutFieldsFunc(fieldNamed(fields))
+}
+
+// WithoutFieldsFunc prevents the fields for which the predicate returns true from ever being LIKE-d, like
+// WithoutFields.
+func WithoutFieldsFunc(predicate func(*schema.Field) bool) Option {
+	return func(like *gormLike) {
+		like.excludedFields = append(like.excludedFields, predicate)
+	}
+}
+
 // SettingOnly makes it so that only queries with the setting 'gormlike' set to true can be turned into LIKE queries.
 // This can be configured using db.Set("gormlike", true) on the query.
 func SettingOnly() Option {
@@ -33,12 +116,132 @@ func SettingOnly() Option {
 	}
 }
 
+// CaseInsensitive makes all LIKE queries case-insensitive, regardless of the database. On Postgres this results in an
+// ILIKE query, other databases compare LOWER() values. Individual fields can be made case-insensitive with the
+// `gormlike:"ci"` tag and individual queries using db.Set("gormlike:case_insensitive", true).
+func CaseInsensitive() Option {
+	return func(like *gormLike) {
+		like.caseInsensitive = true
+	}
+}
+
+// AccentInsensitive makes all LIKE queries accent-insensitive, so that "jose" matches "José". Accents are removed from
+// the value and ignored in the column using unaccent() on Postgres, which requires the unaccent extension, and the
+// utf8mb4_0900_ai_ci collation on MySQL, which is case-insensitive as well. SQLite requires the unaccent function of
+// the sqlitefunc package. Individual fields can be made accent-insensitive with the `gormlike:"ai"` tag and individual
+// queries using db.Set("gormlike:accent_insensitive", true).
+func AccentInsensitive() Option {
+	return func(like *gormLike) {
+		like.accentInsensitive = true
+	}
+}
+
+// WithMaxWildcards rejects patterns with more than the given amount of wildcards, to prevent expensive queries. It can
+// be overridden per field with the `gormlike:"max=3"` tag.
+func WithMaxWildcards(maxWildcards int) Option {
+	return func(like *gormLike) {
+		like.maxWildcards = maxWildcards
+	}
+}
+
+// WithMaxPatternLength rejects patterns longer than the given amount of characters, to prevent expensive queries. It
+// can be overridden per field with the `gormlike:"maxlen=20"` tag.
+func WithMaxPatternLength(maxLength int) Option {
+	return func(like *gormLike) {
+		like.maxPatternLength = maxLength
+	}
+}
+
+// WithForbidLeadingWildcard rejects patterns that start with a wildcard, because these can't use an index and result
+// in a full table scan. It can be enabled per field with the `gormlike:"noleading"` tag.
+func WithForbidLeadingWildcard() Option {
+	return func(like *gormLike) {
+		like.forbidLeadingWildcard = true
+	}
+}
+
+// DegradeRejectedPatterns turns patterns rejected by WithMaxWildcards, WithMaxPatternLength or
+// WithForbidLeadingWildcard into normal equality checks, instead of failing the query with an error like
+// ErrTooManyWildcards.
+func DegradeRejectedPatterns() Option {
+	return func(like *gormLike) {
+		like.degradeRejected = true
+	}
+}
+
+// OptimizePrefixPatterns turns patterns that only have a trailing wildcard, like "abc%", into range conditions like
+// `column >= 'abc' AND column < 'abd'`, which databases can use a B-tree index for. This is only applied to
+// case-sensitive conditions on text columns. Only use this if the collation of your columns compares characters by
+// their code point, like the C collation on Postgres or binary collations on MySQL, other collations might sort
+// values differently.
+func OptimizePrefixPatterns() Option {
+	return func(like *gormLike) {
+		like.prefixRanges = true
+	}
+}
+
+// SQLiteGlob makes case-sensitive conditions use GLOB on SQLite, where LIKE ignores the case of ASCII characters. This
+// makes SQLite, which is often used in tests, match the same records as LIKE on Postgres. It's also used by Like,
+// StartsWith, EndsWith, Contains and Search if the plugin is registered. Other databases are unaffected.
+func SQLiteGlob() Option {
+	return func(like *gormLike) {
+		like.sqliteGlob = true
+	}
+}
+
+// Strict fails queries with an error when a pattern was given for a field that can't be LIKE-d, instead of silently
+// running a normal query. These errors are ErrUnknownField, ErrNotLikeable, ErrTagForbidden and ErrFieldNotAllowed.
+func Strict() Option {
+	return func(like *gormLike) {
+		like.strict = true
+	}
+}
+
+// OnUpdate makes the plugin turn conditions of updates into LIKE queries as well, so that
+// db.Where(...).Updates(...) changes the same records as db.Where(...).Find(...) returns.
+func OnUpdate() Option {
+	return func(like *gormLike) {
+		like.onUpdate = true
+	}
+}
+
+// OnDelete makes the plugin turn conditions of deletes into LIKE queries as well, so that
+// db.Where(...).Delete(...) removes the same records as db.Where(...).Find(...) returns.
+func OnDelete() Option {
+	return func(like *gormLike) {
+		like.onDelete = true
+	}
+}
+
+// OnRow makes the plugin turn conditions of db.Row() and db.Rows() calls into LIKE queries as well.
+func OnRow() Option {
+	return func(like *gormLike) {
+		like.onRow = true
+	}
+}
+
+// WithLogger makes the plugin report its rewrite decisions to the given logger on the debug level. Filter values are
+// redacted unless WithValueLogging is given as well. Without a logger, the plugin is silent.
+func WithLogger(logger *slog.Logger) Option {
+	return func(like *gormLike) {
+		like.logger = logger
+	}
+}
+
+// WithValueLogging includes the original filter values in the logs, instead of redacting them. Be careful with this
+// in production, as filter values may contain sensitive data.
+func WithValueLogging() Option {
+	return func(like *gormLike) {
+		like.logValues = true
+	}
+}
+
 // New creates a new instance of the plugin that can be registered in gorm. Without any settings, all queries will be
 // LIKE-d.
 //
 //nolint:ireturn // Acceptable
 func New(opts ...Option) gorm.Plugin {
-	plugin := &gormLike{}
+	plugin := &gormLike{policies: &policyCache{}}
 
 	for _, opt := range opts {
 		opt(plugin)
@@ -48,9 +251,37 @@ func New(opts ...Option) gorm.Plugin {
 }
 
 type gormLike struct {
-	replaceCharacter   string
-	conditionalTag     bool
-	conditionalSetting bool
+	replaceCharacter      string
+	singleCharacter       string
+	escapeCharacter       string
+	regexDelimiter        string
+	fuzzyPrefix           string
+	fuzzyThreshold        float64
+	fuzzyOrder            bool
+	conditionalTag        bool
+	includedFields        []func(*schema.Field) bool
+	excludedFields        []func(*schema.Field) bool
+	conditionalSetting    bool
+	caseInsensitive       bool
+	accentInsensitive     bool
+	maxWildcards          int
+	maxPatternLength      int
+	forbidLeadingWildcard bool
+	degradeRejected       bool
+	strict                bool
+	prefixRanges          bool
+	sqliteGlob            bool
+	onUpdate              bool
+	onDelete              bool
+	onRow                 bool
+	logger                *slog.Logger
+	logValues             bool
+
+	// queryFields is set using QueryOptions and limits LIKE queries to these columns
+	queryFields []string
+
+	// policies caches the like-ability of fields per schema, it's shared with copies made by withQueryOptions
+	policies *policyCache
 }
 
 func (d *gormLike) Name() string {
@@ -58,5 +289,25 @@ func (d *gormLike) Name() string {
 }
 
 func (d *gormLike) Initialize(db *gorm.DB) error {
-	return db.Callback().Query().Before("gorm:query").Register("gormlike:query", d.queryCallback)
+	if err := db.Callback().Query().Before("gorm:query").Register("gormlike:query", d.queryCallback); err != nil {
+		return err
+	}
+
+	if d.onUpdate {
+		if err := db.Callback().Update().Before("gorm:update").Register("gormlike:update", d.queryCallback); err != nil {
+			return err
+		}
+	}
+
+	if d.onDelete {
+		if err := db.Callback().Delete().Before("gorm:delete").Register("gormlike:delete", d.queryCallback); err != nil {
+			return err
+		}
+	}
+
+	if d.onRow {
+		return db.Callback().Row().Before("gorm:row").Register("gormlike:row", d.queryCallback)
+	}
+
+	return nil
 }
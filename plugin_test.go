@@ -32,3 +32,55 @@ func TestDeepGorm_Initialize_RegistersCallback(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, db.Callback().Query().Get("gormlike:query"))
 }
+
+func TestDeepGorm_Initialize_RegistersOptionalCallbacks(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		options []Option
+
+		expectUpdate bool
+		expectDelete bool
+		expectRow    bool
+	}{
+		"nothing": {},
+		"update": {
+			options:      []Option{OnUpdate()},
+			expectUpdate: true,
+		},
+		"delete": {
+			options:      []Option{OnDelete()},
+			expectDelete: true,
+		},
+		"row": {
+			options:   []Option{OnRow()},
+			expectRow: true,
+		},
+		"all": {
+			options:      []Option{OnUpdate(), OnDelete(), OnRow()},
+			expectUpdate: true,
+			expectDelete: true,
+			expectRow:    true,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			plugin := New(testData.options...)
+
+			// Act
+			err := plugin.Initialize(db)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotNil(t, db.Callback().Query().Get("gormlike:query"))
+			assert.Equal(t, testData.expectUpdate, db.Callback().Update().Get("gormlike:update") != nil)
+			assert.Equal(t, testData.expectDelete, db.Callback().Delete().Get("gormlike:delete") != nil)
+			assert.Equal(t, testData.expectRow, db.Callback().Row().Get("gormlike:row") != nil)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package gormlike
+
+import (
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// fieldPolicy contains everything the plugin needs to know to rewrite conditions on a field, so that the tag and
+// type of the field don't have to be inspected for every condition
+type fieldPolicy struct {
+	// tag contains the parsed `gormlike` tag of the field
+	tag likeTag
+
+	// kind describes whether the column has to be cast to text
+	kind likeKind
+
+	// table is the table of the field's schema if it has the fts tag, which is where SQLite's FTS5 searches have to look
+	// when the column is qualified with a join alias
+	table string
+
+	// err is ErrTagForbidden or ErrNotLikeable if conditions on the field may not be LIKE-d, reason is logged with it
+	err    error
+	reason string
+}
+
+// character returns the replacement character of the field, which is the one in its tag or the given default
+func (p *fieldPolicy) character(defaultCharacter string) string {
+	if p.tag.character != "" {
+		return p.tag.character
+	}
+
+	return defaultCharacter
+}
+
+// schemaPolicy contains the policies of all fields in a schema keyed by the name of their column, err is set if one
+// of the fields has an invalid tag
+type schemaPolicy struct {
+	fields map[string]*fieldPolicy
+	err    error
+}
+
+// policyCache stores the policies of schemas, the key is a *schema.Schema and the value a *schemaPolicy
+type policyCache struct {
+	schemas sync.Map
+}
+
+// schemaPolicy returns the policies of all fields in the schema, which are computed the first time the schema is used
+func (d *gormLike) schemaPolicy(dbSchema *schema.Schema) *schemaPolicy {
+	if cached, ok := d.policies.schemas.Load(dbSchema); ok {
+		return cached.(*schemaPolicy)
+	}
+
+	result := &schemaPolicy{fields: make(map[string]*fieldPolicy, len(dbSchema.Fields))}
+
+	for _, schemaField := range dbSchema.Fields {
+		if schemaField.DBName == "" {
+			continue
+		}
+
+		policy, err := d.newFieldPolicy(schemaField)
+		if err != nil {
+			result.err = err
+			break
+		}
+
+		result.fields[schemaField.DBName] = policy
+	}
+
+	// Another goroutine may have been first, in which case both results are the same
+	actual, _ := d.policies.schemas.LoadOrStore(dbSchema, result)
+
+	return actual.(*schemaPolicy)
+}
+
+// fieldPolicy returns the policy of the field, or an error wrapping ErrInvalidTag if its schema has an invalid tag
+func (d *gormLike) fieldPolicy(dbField *schema.Field) (*fieldPolicy, error) {
+	// Columns not found in a schema are treated as untagged text columns
+	if dbField == nil || dbField.Schema == nil {
+		return d.newFieldPolicy(dbField)
+	}
+
+	policies := d.schemaPolicy(dbField.Schema)
+	if policies.err != nil {
+		return nil, policies.err
+	}
+
+	if policy, ok := policies.fields[dbField.DBName]; ok {
+		return policy, nil
+	}
+
+	// The field isn't part of its own schema, which can happen with hand-made fields
+	return d.newFieldPolicy(dbField)
+}
+
+// newFieldPolicy computes the policy of a field using its tag, type and the options of the plugin
+func (d *gormLike) newFieldPolicy(dbField *schema.Field) (*fieldPolicy, error) {
+	policy := &fieldPolicy{kind: fieldKind(dbField)}
+
+	if dbField != nil {
+		tag, err := parseTag(dbField.Tag.Get(tagName))
+		if err != nil {
+			return nil, fmt.Errorf("%w on field %s", err, fieldName(dbField))
+		}
+
+		policy.tag = tag
+	}
+
+	// Full-text indexes can only be created on text columns
+	if policy.tag.fullText && policy.kind != textField {
+		return nil, fmt.Errorf("%w: setting %q requires a text column on field %s", ErrInvalidTag, fullTextTag, fieldName(dbField))
+	}
+
+	if policy.tag.fullText && dbField.Schema != nil {
+		policy.table = dbField.Schema.Table
+	}
+
+	switch {
+	// If the user has explicitly set this to false, ignore this field
+	case policy.tag.disabled:
+		policy.err, policy.reason = ErrTagForbidden, reasonTagDisabled
+
+	case anyField(d.excludedFields, dbField):
+		policy.err, policy.reason = ErrFieldNotAllowed, reasonFieldNotAllowed
+
+	// If tags are required and the tag is not true, ignore this field
+	case d.conditionalTag && !policy.tag.enabled && !anyField(d.includedFields, dbField):
+		policy.err, policy.reason = ErrTagForbidden, reasonTagMissing
+
+	case len(d.includedFields) > 0 && !policy.tag.enabled && !anyField(d.includedFields, dbField):
+		policy.err, policy.reason = ErrFieldNotAllowed, reasonFieldNotAllowed
+
+	case policy.kind == unlikeableField:
+		policy.err, policy.reason = ErrNotLikeable, reasonNotLikeable
+	}
+
+	return policy, nil
+}
+
+// fieldName returns the name of the field prefixed with the name of its model, if known
+func fieldName(dbField *schema.Field) string {
+	if dbField.Schema == nil {
+		return dbField.Name
+	}
+
+	return dbField.Schema.Name + "." + dbField.Name
+}
+
+// anyField returns whether any of the predicates returns true for the field, fields that aren't in the schema never
+// match
+func anyField(predicates []func(*schema.Field) bool, dbField *schema.Field) bool {
+	if dbField == nil {
+		return false
+	}
+
+	for _, predicate := range predicates {
+		if predicate(dbField) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,268 @@
+package gormlike
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+)
+
+func TestGormLike_FieldPolicy_ReturnsExpectedPolicy(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name        string
+		Tagged      string `gormlike:"true"`
+		Forbidden   string `gormlike:"false"`
+		Insensitive string `gormlike:"ci;max=2"`
+		FullText    string `gormlike:"fts"`
+		Age         int
+		Data        []byte
+	}
+
+	tests := map[string]struct {
+		field   string
+		options []Option
+
+		expected fieldPolicy
+	}{
+		"full-text": {
+			field:    "full_text",
+			expected: fieldPolicy{kind: textField, tag: likeTag{enabled: true, fullText: true}, table: "object_as"},
+		},
+		"untagged": {
+			field:    "name",
+			expected: fieldPolicy{kind: textField},
+		},
+		"tagged": {
+			field:    "tagged",
+			expected: fieldPolicy{kind: textField, tag: likeTag{enabled: true}},
+		},
+		"forbidden": {
+			field:    "forbidden",
+			expected: fieldPolicy{kind: textField, tag: likeTag{disabled: true}, err: ErrTagForbidden, reason: reasonTagDisabled},
+		},
+		"case-insensitive": {
+			field:    "insensitive",
+			expected: fieldPolicy{kind: textField, tag: likeTag{enabled: true, caseInsensitive: true, maxWildcards: 2}},
+		},
+		"cast": {
+			field:    "age",
+			expected: fieldPolicy{kind: castField},
+		},
+		"binary": {
+			field:    "data",
+			expected: fieldPolicy{kind: unlikeableField, err: ErrNotLikeable, reason: reasonNotLikeable},
+		},
+		"untagged with TaggedOnly": {
+			field:    "name",
+			options:  []Option{TaggedOnly()},
+			expected: fieldPolicy{kind: textField, err: ErrTagForbidden, reason: reasonTagMissing},
+		},
+		"tagged with TaggedOnly": {
+			field:    "tagged",
+			options:  []Option{TaggedOnly()},
+			expected: fieldPolicy{kind: textField, tag: likeTag{enabled: true}},
+		},
+		"listed with WithFields": {
+			field:    "name",
+			options:  []Option{WithFields("object_as.name")},
+			expected: fieldPolicy{kind: textField},
+		},
+		"not listed with WithFields": {
+			field:    "age",
+			options:  []Option{WithFields("object_as.name")},
+			expected: fieldPolicy{kind: castField, err: ErrFieldNotAllowed, reason: reasonFieldNotAllowed},
+		},
+		"tagged and not listed with WithFields": {
+			field:    "tagged",
+			options:  []Option{WithFields("object_as.name")},
+			expected: fieldPolicy{kind: textField, tag: likeTag{enabled: true}},
+		},
+		"forbidden and listed with WithFields": {
+			field:    "forbidden",
+			options:  []Option{WithFields("forbidden")},
+			expected: fieldPolicy{kind: textField, tag: likeTag{disabled: true}, err: ErrTagForbidden, reason: reasonTagDisabled},
+		},
+		"listed with WithFields and TaggedOnly": {
+			field:    "name",
+			options:  []Option{TaggedOnly(), WithFields("name")},
+			expected: fieldPolicy{kind: textField},
+		},
+		"not listed with WithFields and TaggedOnly": {
+			field:    "age",
+			options:  []Option{TaggedOnly(), WithFields("name")},
+			expected: fieldPolicy{kind: castField, err: ErrTagForbidden, reason: reasonTagMissing},
+		},
+		"listed with WithFieldsFunc": {
+			field: "age",
+			options: []Option{WithFieldsFunc(func(field *schema.Field) bool {
+				return field.DataType == schema.Int
+			})},
+			expected: fieldPolicy{kind: castField},
+		},
+		"listed with WithoutFields": {
+			field:    "name",
+			options:  []Option{WithoutFields("ObjectA.Name")},
+			expected: fieldPolicy{kind: textField, err: ErrFieldNotAllowed, reason: reasonFieldNotAllowed},
+		},
+		"tagged and listed with WithoutFields": {
+			field:    "tagged",
+			options:  []Option{WithoutFields("tagged")},
+			expected: fieldPolicy{kind: textField, tag: likeTag{enabled: true}, err: ErrFieldNotAllowed, reason: reasonFieldNotAllowed},
+		},
+		"not listed with WithoutFields": {
+			field:    "name",
+			options:  []Option{WithoutFields("users.name")},
+			expected: fieldPolicy{kind: textField},
+		},
+		"listed with WithoutFieldsFunc": {
+			field: "age",
+			options: []Option{WithoutFieldsFunc(func(field *schema.Field) bool {
+				return field.DataType == schema.Int
+			})},
+			expected: fieldPolicy{kind: castField, err: ErrFieldNotAllowed, reason: reasonFieldNotAllowed},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			objectSchema, err := schema.Parse(&ObjectA{}, &sync.Map{}, schema.NamingStrategy{})
+			if err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			result, err := plugin.fieldPolicy(objectSchema.FieldsByDBName[testData.field])
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, *result)
+		})
+	}
+}
+
+func TestGormLike_FieldPolicy_ReturnsDefaultPolicyForUnknownFields(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	plugin, _ := New(TaggedOnly()).(*gormLike)
+
+	// Act
+	result, err := plugin.fieldPolicy(nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fieldPolicy{kind: textField, err: ErrTagForbidden, reason: reasonTagMissing}, *result)
+}
+
+func TestGormLike_FieldPolicy_CachesPoliciesPerSchema(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name string
+		Age  int
+	}
+
+	// Arrange
+	plugin, _ := New().(*gormLike)
+
+	objectSchema, err := schema.Parse(&ObjectA{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	otherSchema, err := schema.Parse(&ObjectA{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	results := make([]*fieldPolicy, 10)
+
+	for index := range results {
+		wg.Add(1)
+
+		go func(index int) {
+			defer wg.Done()
+			results[index], _ = plugin.fieldPolicy(objectSchema.FieldsByDBName["name"])
+		}(index)
+	}
+
+	wg.Wait()
+
+	// Assert
+	cached, _ := plugin.policies.schemas.Load(objectSchema)
+	policies, _ := cached.(*schemaPolicy)
+
+	for _, result := range results {
+		assert.Same(t, policies.fields["name"], result)
+	}
+
+	agePolicy, _ := plugin.fieldPolicy(objectSchema.FieldsByDBName["age"])
+	assert.Same(t, policies.fields["age"], agePolicy)
+
+	otherPolicy, _ := plugin.fieldPolicy(otherSchema.FieldsByDBName["name"])
+	assert.NotSame(t, policies.fields["name"], otherPolicy)
+}
+
+func TestGormLike_FieldPolicy_ReturnsErrorOnInvalidTag(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name  string
+		Other string `gormlike:"true;maximum=3"`
+	}
+
+	// Arrange
+	plugin, _ := New().(*gormLike)
+
+	objectSchema, err := schema.Parse(&ObjectA{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	// Act
+	result, err := plugin.fieldPolicy(objectSchema.FieldsByDBName["name"])
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	assert.EqualError(t, err, `gormlike: invalid tag: unknown setting "maximum" on field ObjectA.Other`)
+}
+
+func TestGormLike_FieldPolicy_ReturnsErrorOnFullTextTagOnNonTextField(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name string `gormlike:"fts"`
+		Age  int    `gormlike:"fts"`
+	}
+
+	// Arrange
+	plugin, _ := New().(*gormLike)
+
+	objectSchema, err := schema.Parse(&ObjectA{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	// Act
+	result, err := plugin.fieldPolicy(objectSchema.FieldsByDBName["name"])
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	assert.EqualError(t, err, `gormlike: invalid tag: setting "fts" requires a text column on field ObjectA.Age`)
+}
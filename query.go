@@ -1,175 +1,482 @@
 package gormlike
 
 import (
+	"errors"
 	"fmt"
-	"strings"
+	"reflect"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-const tagName = "gormlike"
+const (
+	tagName = "gormlike"
+
+	// caseInsensitiveSetting can be set on a query to override the CaseInsensitive option
+	caseInsensitiveSetting = "gormlike:case_insensitive"
+
+	// caseInsensitiveTag can be used as the tag value to make a field like-able and case-insensitive
+	caseInsensitiveTag = "ci"
+
+	// accentInsensitiveSetting can be set on a query to override the AccentInsensitive option
+	accentInsensitiveSetting = "gormlike:accent_insensitive"
+
+	// accentInsensitiveTag can be used as the tag value to make a field like-able and accent-insensitive
+	accentInsensitiveTag = "ai"
+
+	// fullTextTag can be used as the tag value to make a field like-able using full-text search
+	fullTextTag = "fts"
+)
 
-//nolint:gocognit,cyclop // Acceptable
 func (d *gormLike) queryCallback(db *gorm.DB) {
-	fmt.Printf("Starting queryCallback \n")
+	// Invalid tags are a mistake in the code rather than the filter, so they fail queries regardless of the settings
+	if db.Statement.Schema != nil {
+		if err := d.schemaPolicy(db.Statement.Schema).err; err != nil {
+			_ = db.AddError(err)
+			return
+		}
+	}
+
 	// If we only want to like queries that are explicitly set to true, we back out early if anything's amiss
 	settingValue, settingOk := db.Get(tagName)
 	if d.conditionalSetting && !settingOk {
+		d.logQuerySkip(db, reasonSettingMissing)
 		return
 	}
 
 	if settingOk {
 		if boolValue, _ := settingValue.(bool); !boolValue {
+			d.logQuerySkip(db, reasonSettingDisabled)
 			return
 		}
 	}
 
-	fmt.Printf("Starting queryCallback 2\n")
-	exp, settingOk := db.Statement.Clauses["WHERE"].Expression.(clause.Where)
+	// Options of the query take precedence over the options of the plugin
+	d, err := d.withQueryOptions(db)
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+
+	opts := conditionOptions{
+		caseInsensitive:   d.caseInsensitive,
+		accentInsensitive: d.accentInsensitive,
+		prefixRanges:      d.prefixRanges,
+		glob:              d.sqliteGlob,
+	}
+
+	if settingValue, ok := db.Get(caseInsensitiveSetting); ok {
+		opts.caseInsensitive, _ = settingValue.(bool)
+	}
+
+	if settingValue, ok := db.Get(accentInsensitiveSetting); ok {
+		opts.accentInsensitive, _ = settingValue.(bool)
+	}
+
+	whereClause := db.Statement.Clauses["WHERE"]
+
+	exp, settingOk := whereClause.Expression.(clause.Where)
 	if !settingOk {
-		fmt.Printf("early stop at where\n")
 		return
 	}
 
-	fmt.Printf("exp.Exprs = %v", exp.Exprs)
+	// The expressions are copied, since statements created using Session share them with the statement they came from
+	var changed bool
+	exprs := make([]clause.Expression, len(exp.Exprs))
+
 	for index, cond := range exp.Exprs {
-		switch cond := cond.(type) {
-		case clause.Eq:
-			fmt.Printf("clause eq\n")
-			columnName, columnOk := cond.Column.(string)
-			if !columnOk {
-				continue
-			}
-			fmt.Printf("column name = %s\n", columnName)
+		exprs[index] = cond
 
-			// Get the `gormlike` value
-			var tagValue string
-			dbField, ok := db.Statement.Schema.FieldsByDBName[columnName]
-			if ok {
-				tagValue = dbField.Tag.Get(tagName)
-			}
+		if expression, ok := d.rewriteExpression(db, cond, opts); ok {
+			exprs[index] = expression
+			changed = true
+		}
+	}
 
-			// If the user has explicitly set this to false, ignore this field
-			if tagValue == "false" {
-				continue
-			}
+	if changed {
+		whereClause.Expression = clause.Where{Exprs: exprs}
+		db.Statement.Clauses["WHERE"] = whereClause
+	}
+}
 
-			// If tags are required and the tag is not true, ignore this field
-			if d.conditionalTag && tagValue != "true" {
-				continue
-			}
+// rewriteExpression returns the LIKE version of the given expression, or false if it should not be changed
+func (d *gormLike) rewriteExpression(db *gorm.DB, expression clause.Expression, opts conditionOptions) (clause.Expression, bool) {
+	switch expression := expression.(type) {
+	case clause.Eq:
+		return d.rewriteEq(db, expression.Column, expression.Value, opts)
+	case clause.Neq:
+		opts.negated = !opts.negated
+		return d.rewriteEq(db, expression.Column, expression.Value, opts)
+	case clause.IN:
+		return d.rewriteIN(db, expression, opts)
+	case clause.NotConditions:
+		return d.rewriteNot(db, expression, opts)
+	case clause.AndConditions:
+		return d.rewriteGroup(db, expression.Exprs, opts, func(exprs []clause.Expression) clause.Expression {
+			return clause.AndConditions{Exprs: exprs}
+		})
+	case clause.OrConditions:
+		return d.rewriteGroup(db, expression.Exprs, opts, func(exprs []clause.Expression) clause.Expression {
+			return clause.OrConditions{Exprs: exprs}
+		})
+	case clause.Where:
+		return d.rewriteGroup(db, expression.Exprs, opts, func(exprs []clause.Expression) clause.Expression {
+			return clause.Where{Exprs: exprs}
+		})
+	default:
+		return nil, false
+	}
+}
 
-			value, columnOk := cond.Value.(string)
-			if !columnOk {
-				continue
-			}
+// rewriteNot negates all expressions in the NOT condition, so that they can be rewritten to NOT LIKE conditions
+func (d *gormLike) rewriteNot(db *gorm.DB, not clause.NotConditions, opts conditionOptions) (clause.Expression, bool) {
+	opts.negated = !opts.negated
 
-			// If there are no % AND there aren't ony replaceable characters, just skip it because it's a normal query
-			if !strings.Contains(value, "%") && !(d.replaceCharacter != "" && strings.Contains(value, d.replaceCharacter)) {
-				continue
-			}
+	var changed bool
+	exprs := make([]clause.Expression, len(not.Exprs))
 
-			// UUID has LIKE implementation
-			var condition string
-			// if isLikeableField(dbField.DataType, dbField.FieldType) {
-			if dbField.FieldType.String() != "uuid.UUID" {
-				fmt.Printf("likeable field '%s' with type '%s'\n", dbField.Name, dbField.FieldType)
-				condition = fmt.Sprintf("%s LIKE ?", cond.Column)
-			} else {
-				fmt.Printf("not likeable field '%s' with type '%s'\n", dbField.Name, dbField.FieldType)
-				condition = fmt.Sprintf("CAST(%s as varchar) LIKE ?", cond.Column)
-			}
-			fmt.Printf("condition = %s\n", condition)
+	for index, expression := range not.Exprs {
+		if rewritten, ok := d.rewriteExpression(db, expression, opts); ok {
+			exprs[index] = rewritten
+			changed = true
 
-			if d.replaceCharacter != "" {
-				value = strings.ReplaceAll(value, d.replaceCharacter, "%")
-			}
+			continue
+		}
 
-			exp.Exprs[index] = db.Session(&gorm.Session{NewDB: true}).Where(condition, value).Statement.Clauses["WHERE"].Expression
-		case clause.IN:
-			fmt.Printf("clause ins\n")
-			columnName, columnOk := cond.Column.(string)
-			if !columnOk {
-				continue
-			}
-			fmt.Printf("column name = %s\n", columnName)
+		exprs[index] = clause.Not(expression)
+	}
 
-			// Get the `gormlike` value
-			var tagValue string
-			dbField, ok := db.Statement.Schema.FieldsByDBName[columnName]
-			if ok {
-				tagValue = dbField.Tag.Get(tagName)
-			}
+	// Don't alter the query if it isn't necessary
+	if !changed {
+		return nil, false
+	}
 
-			// If the user has explicitly set this to false, ignore this field
-			if tagValue == "false" {
-				continue
-			}
+	return clause.And(exprs...), true
+}
 
-			// If tags are required and the tag is not true, ignore this field
-			if d.conditionalTag && tagValue != "true" {
-				continue
-			}
+// rewriteGroup rewrites all expressions in a nested group of conditions, like the ones produced by db.Or(...) or
+// db.Where(db.Where(...)). The result is created using newGroup, to retain the type of group.
+func (d *gormLike) rewriteGroup(db *gorm.DB, exprs []clause.Expression, opts conditionOptions, newGroup func([]clause.Expression) clause.Expression) (clause.Expression, bool) {
+	// The group as a whole is negated, not the individual conditions
+	negated := opts.negated
+	opts.negated = false
 
-			var likeCounter int
-			var useOr bool
+	var changed bool
 
-			query := db.Session(&gorm.Session{NewDB: true})
+	// The expressions are copied to prevent changing queries that were used to build this one
+	result := make([]clause.Expression, len(exprs))
 
-			for _, value := range cond.Values {
-				value, ok := value.(string)
-				if !ok {
-					continue
-				}
-				condition := fmt.Sprintf("%s = ?", cond.Column)
-
-				// If there are no % AND there aren't ony replaceable characters, just skip it because it's a normal query
-				if (strings.Contains(value, "%") && d.replaceCharacter == "") || (d.replaceCharacter != "" && strings.Contains(value, d.replaceCharacter)) {
-
-					// UUID has LIKE implementation
-					if dbField.FieldType.String() != "uuid.UUID" {
-						// if isLikeableField(dbField.DataType, dbField.FieldType) {
-						fmt.Printf("likeable field '%s' with type '%s'\n", dbField.Name, dbField.FieldType)
-						condition = fmt.Sprintf("%s LIKE ?", cond.Column)
-					} else {
-						fmt.Printf("not likeable field '%s' with type '%s'\n", dbField.Name, dbField.FieldType)
-						condition = fmt.Sprintf("CAST(%s as varchar) LIKE ?", cond.Column)
-					}
-					fmt.Printf("condition = %s\n", condition)
+	for index, expression := range exprs {
+		result[index] = expression
+
+		if rewritten, ok := d.rewriteExpression(db, expression, opts); ok {
+			result[index] = rewritten
+			changed = true
+		}
+	}
+
+	// Don't alter the query if it isn't necessary
+	if !changed {
+		return nil, false
+	}
+
+	if negated {
+		return clause.Not(newGroup(result)), true
+	}
+
+	return newGroup(result), true
+}
+
+// rewriteEq turns a single-value condition into a LIKE condition if wildcards were found
+func (d *gormLike) rewriteEq(db *gorm.DB, column any, value any, opts conditionOptions) (clause.Expression, bool) {
+	columnName, policy, err := d.resolveField(db, column, value)
+	if err != nil {
+		d.refuseField(db, columnName, err, d.containsWildcards([]any{value}))
+		return nil, false
+	}
+
+	stringValue, isString := toString(value)
+	if !isString {
+		d.logSkip(db, columnName, value, reasonNoStringValue)
+		return nil, false
+	}
+
+	opts.caseInsensitive = opts.caseInsensitive || policy.tag.caseInsensitive
+	opts.accentInsensitive = opts.accentInsensitive || policy.tag.accentInsensitive
+
+	if condition, vars, marked, err := d.markedCondition(db, column, policy, stringValue, opts, !opts.negated); marked {
+		if err != nil {
+			d.rejectPattern(db, columnName, stringValue, err)
+
+			// A degraded value is just the original equality check
+			return nil, false
+		}
+
+		d.logRewrite(db, columnName, stringValue, condition)
+
+		return clause.Expr{SQL: condition, Vars: vars}, true
+	}
+
+	pattern, literal, wildcards := d.convertValue(stringValue, policy.character(d.replaceCharacter))
+
+	if wildcards == 0 {
+		d.logSkip(db, columnName, value, reasonNoWildcards)
+
+		// Escaped characters have to be removed, even if it's a normal query
+		if literal == stringValue {
+			return nil, false
+		}
+
+		if opts.negated {
+			return clause.Neq{Column: column, Value: literal}, true
+		}
+
+		return clause.Eq{Column: column, Value: literal}, true
+	}
+
+	if err := d.checkPattern(stringValue, pattern, wildcards, policy.tag); err != nil {
+		d.rejectPattern(db, columnName, stringValue, err)
+
+		// A degraded pattern is just the original equality check
+		return nil, false
+	}
 
-					if d.replaceCharacter != "" {
-						value = strings.ReplaceAll(value, d.replaceCharacter, "%")
+	condition, vars := patternCondition(db, column, policy, pattern, opts)
+	d.logRewrite(db, columnName, stringValue, condition)
+
+	return clause.Expr{SQL: condition, Vars: vars}, true
+}
+
+// rewriteIN turns a multi-value condition into a group of OR-ed LIKE and equality conditions if wildcards were found
+func (d *gormLike) rewriteIN(db *gorm.DB, cond clause.IN, opts conditionOptions) (clause.Expression, bool) {
+	columnName, policy, err := d.resolveField(db, cond.Column, cond.Values)
+	if err != nil {
+		d.refuseField(db, columnName, err, d.containsWildcards(cond.Values))
+		return nil, false
+	}
+
+	// The group as a whole is negated, not the individual conditions
+	negated := opts.negated
+	opts.negated = false
+	opts.caseInsensitive = opts.caseInsensitive || policy.tag.caseInsensitive
+	opts.accentInsensitive = opts.accentInsensitive || policy.tag.accentInsensitive
+
+	var likeCounter int
+	var changed bool
+
+	exprs := make([]clause.Expression, 0, len(cond.Values))
+
+	for _, value := range cond.Values {
+		condition := fmt.Sprintf("%s = ?", columnSQL(db, cond.Column))
+		vars := []any{value}
+
+		if value, ok := toString(value); ok {
+			if markedSQL, markedVars, marked, err := d.markedCondition(db, cond.Column, policy, value, opts, !negated); marked {
+				if err != nil {
+					d.rejectPattern(db, columnName, value, err)
+
+					// A degraded value is just the original equality check
+					if !d.degradeRejected {
+						return nil, false
 					}
 
-					likeCounter++
+					exprs = append(exprs, clause.Expr{SQL: condition, Vars: vars})
+					changed = true
+
+					continue
 				}
 
-				if useOr {
-					query = query.Or(condition, value)
+				d.logRewrite(db, columnName, value, markedSQL)
+				exprs = append(exprs, clause.Expr{SQL: markedSQL, Vars: markedVars})
+				likeCounter++
+
+				continue
+			}
+
+			pattern, literal, wildcards := d.convertValue(value, policy.character(d.replaceCharacter))
+			vars = []any{literal}
+			changed = changed || literal != value
+
+			if wildcards > 0 {
+				if err := d.checkPattern(value, pattern, wildcards, policy.tag); err != nil {
+					d.rejectPattern(db, columnName, value, err)
+
+					// A degraded pattern is just the original equality check
+					if !d.degradeRejected {
+						return nil, false
+					}
+
+					exprs = append(exprs, clause.Expr{SQL: condition, Vars: []any{value}})
+					changed = true
 
 					continue
 				}
 
-				query = query.Where(condition, value)
-				useOr = true
+				condition, vars = patternCondition(db, cond.Column, policy, pattern, opts)
+				d.logRewrite(db, columnName, value, condition)
+
+				likeCounter++
 			}
+		}
 
-			// Don't alter the query if it isn't necessary
-			if likeCounter == 0 {
-				continue
+		exprs = append(exprs, clause.Expr{SQL: condition, Vars: vars})
+	}
+
+	// Don't alter the query if it isn't necessary
+	if likeCounter == 0 {
+		d.logSkip(db, columnName, cond.Values, reasonNoWildcards)
+
+		if !changed {
+			return nil, false
+		}
+	}
+
+	var group clause.Expression = clause.OrConditions{Exprs: exprs}
+	if len(exprs) == 1 {
+		group = exprs[0]
+	}
+
+	if negated {
+		return clause.Not(group), true
+	}
+
+	return group, true
+}
+
+// markedCondition returns the condition for values that are marked as a regular expression or fuzzy value, it returns
+// false if the value isn't marked and an error if it exceeds the limits of the plugin. Results are ordered by their
+// similarity to fuzzy values if ordered is true and OrderByFuzzySimilarity was given.
+func (d *gormLike) markedCondition(db *gorm.DB, column any, policy *fieldPolicy, value string, opts conditionOptions, ordered bool) (string, []any, bool, error) {
+	if expression, ok := d.regexValue(value); ok {
+		if err := d.checkRegex(expression, policy.tag); err != nil {
+			return "", nil, true, err
+		}
+
+		condition, vars := regexCondition(db, column, policy.kind, expression, opts)
+
+		return condition, vars, true, nil
+	}
+
+	if term, ok := d.fuzzyValue(value); ok {
+		if err := d.checkFuzzy(term, policy.tag); err != nil {
+			return "", nil, true, err
+		}
+
+		if d.fuzzyOrder && ordered {
+			orderBySimilarity(db, column, policy.kind, term, opts)
+		}
+
+		condition, vars := fuzzyCondition(db, column, policy.kind, term, d.fieldThreshold(policy.tag), opts)
+
+		return condition, vars, true, nil
+	}
+
+	return "", nil, false, nil
+}
+
+// resolveField looks up the field of the column in the schema and checks whether it may be LIKE-d, it returns an
+// error like ErrTagForbidden if the condition should be left alone
+func (d *gormLike) resolveField(db *gorm.DB, column any, value any) (string, *fieldPolicy, error) {
+	table, name, ok := splitColumn(column)
+	if !ok {
+		d.logSkip(db, fmt.Sprint(column), value, reasonNoStringColumn)
+		return fmt.Sprint(column), nil, ErrNotLikeable
+	}
+
+	columnName := name
+	if table != "" && table != clause.CurrentTable {
+		columnName = table + "." + name
+	}
+
+	dbField := lookupField(db, table, name)
+
+	// Without a schema, we can't tell whether the field exists
+	if dbField == nil && d.strict && db.Statement.Schema != nil {
+		d.logSkip(db, columnName, value, reasonUnknownField)
+		return columnName, nil, ErrUnknownField
+	}
+
+	if len(d.queryFields) > 0 && !columnMatches(db, table, name, d.queryFields) {
+		d.logSkip(db, columnName, value, reasonFieldNotAllowed)
+		return columnName, nil, ErrFieldNotAllowed
+	}
+
+	policy, err := d.fieldPolicy(dbField)
+	if err != nil {
+		d.logSkip(db, columnName, value, reasonInvalidTag)
+		return columnName, nil, err
+	}
+
+	if policy.err != nil {
+		d.logSkip(db, columnName, value, policy.reason)
+		return columnName, nil, policy.err
+	}
+
+	return columnName, policy, nil
+}
+
+// refuseField reports a condition that can't be LIKE-d, which fails the query in strict mode if the user did intend
+// it to be a LIKE query
+func (d *gormLike) refuseField(db *gorm.DB, columnName string, err error, wildcards bool) {
+	switch {
+	// Invalid tags are a mistake in the code rather than the filter, so they always fail the query
+	case errors.Is(err, ErrInvalidTag):
+		_ = db.AddError(err)
+	case d.strict && wildcards:
+		_ = db.AddError(fmt.Errorf("%w: %s", err, columnName))
+	}
+}
+
+// containsWildcards returns whether any of the values would be turned into a LIKE pattern, regular expression or fuzzy
+// condition
+func (d *gormLike) containsWildcards(values []any) bool {
+	for _, value := range values {
+		if value, ok := toString(value); ok {
+			if _, isRegex := d.regexValue(value); isRegex {
+				return true
+			}
+
+			if _, isFuzzy := d.fuzzyValue(value); isFuzzy {
+				return true
 			}
 
-			fmt.Printf("Replacing with where = %v\n", query)
-			exp.Exprs[index] = db.Session(&gorm.Session{NewDB: true}).Where(query).Statement.Clauses["WHERE"].Expression
+			if _, _, wildcards := d.convertValue(value, d.replaceCharacter); wildcards > 0 {
+				return true
+			}
 		}
 	}
+
+	return false
 }
 
-// func isLikeableField(dataType schema.DataType, fieldType reflect.Type) bool {
-// 	fmt.Printf("isLikeableField with fieldtype '%s' and dataType '%v'\n", fieldType, dataType)
-// 	if fieldType.String() == "uuid.UUID" {
-// 		return false
-// 	}
-// 	return dataType == schema.String || dataType == schema.Bool || dataType == schema.Int || dataType == schema.Uint || dataType == schema.Float || dataType == schema.Time || dataType == schema.Bytes
-// }
+// toString returns the value if it's a string, a custom string type or a pointer to either
+func toString(value any) (string, bool) {
+	if value, ok := value.(string); ok {
+		return value, true
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			return "", false
+		}
+
+		reflectValue = reflectValue.Elem()
+	}
+
+	if reflectValue.Kind() != reflect.String {
+		return "", false
+	}
+
+	return reflectValue.String(), true
+}
+
+// rejectPattern reports a pattern that exceeded the limits of the plugin, which fails the query unless
+// DegradeRejectedPatterns was given
+func (d *gormLike) rejectPattern(db *gorm.DB, columnName string, value string, err error) {
+	d.logReject(db, columnName, value, err)
+
+	if !d.degradeRejected {
+		_ = db.AddError(fmt.Errorf("%w: %s", err, columnName))
+	}
+}
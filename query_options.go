@@ -0,0 +1,85 @@
+package gormlike
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// queryOptionsSetting can be set on a query to override the options of the plugin using QueryOptions
+const queryOptionsSetting = "gormlike:options"
+
+// QueryOptions overrides the options of the plugin for a single query, so that different queries can use different
+// wildcard rules without registering the plugin twice. Set it using db.Set("gormlike:options", gormlike.QueryOptions{})
+// on the query. Fields that are left empty keep the configuration of the plugin, `gormlike` tags still take precedence.
+type QueryOptions struct {
+	// Character overrides WithCharacter
+	Character string
+
+	// SingleCharacter overrides WithSingleCharacter
+	SingleCharacter string
+
+	// CaseInsensitive makes all conditions of the query case-insensitive, like CaseInsensitive
+	CaseInsensitive bool
+
+	// AccentInsensitive makes all conditions of the query accent-insensitive, like AccentInsensitive
+	AccentInsensitive bool
+
+	// MaxWildcards overrides WithMaxWildcards
+	MaxWildcards int
+
+	// MaxPatternLength overrides WithMaxPatternLength
+	MaxPatternLength int
+
+	// Fields limits LIKE queries to these columns, like "name" or "users.name", conditions on other columns are
+	// normal queries
+	Fields []string
+}
+
+// withQueryOptions returns a copy of the plugin with the QueryOptions of the query applied, or the plugin itself if
+// the query has none. It returns an error wrapping ErrInvalidQueryOptions if the setting is not a QueryOptions.
+func (d *gormLike) withQueryOptions(db *gorm.DB) (*gormLike, error) {
+	value, ok := db.Get(queryOptionsSetting)
+	if !ok {
+		return d, nil
+	}
+
+	var options QueryOptions
+
+	switch value := value.(type) {
+	case QueryOptions:
+		options = value
+	case *QueryOptions:
+		if value == nil {
+			return d, nil
+		}
+
+		options = *value
+	default:
+		return nil, fmt.Errorf("%w: expected gormlike.QueryOptions, got %T", ErrInvalidQueryOptions, value)
+	}
+
+	result := *d
+
+	if options.Character != "" {
+		result.replaceCharacter = options.Character
+	}
+
+	if options.SingleCharacter != "" {
+		result.singleCharacter = options.SingleCharacter
+	}
+
+	if options.MaxWildcards > 0 {
+		result.maxWildcards = options.MaxWildcards
+	}
+
+	if options.MaxPatternLength > 0 {
+		result.maxPatternLength = options.MaxPatternLength
+	}
+
+	result.caseInsensitive = result.caseInsensitive || options.CaseInsensitive
+	result.accentInsensitive = result.accentInsensitive || options.AccentInsensitive
+	result.queryFields = options.Fields
+
+	return &result, nil
+}
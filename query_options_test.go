@@ -0,0 +1,290 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/ing-bank/gormtestutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGormLike_WithQueryOptions_ReturnsExpectedPlugin(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		options []Option
+		setting any
+
+		expected func(plugin *gormLike)
+	}{
+		"no options": {
+			options:  []Option{WithCharacter("*")},
+			expected: func(plugin *gormLike) {},
+		},
+		"all options": {
+			setting: QueryOptions{
+				Character:         "*",
+				SingleCharacter:   "?",
+				CaseInsensitive:   true,
+				AccentInsensitive: true,
+				MaxWildcards:      2,
+				MaxPatternLength:  20,
+				Fields:            []string{"name"},
+			},
+			expected: func(plugin *gormLike) {
+				plugin.replaceCharacter = "*"
+				plugin.singleCharacter = "?"
+				plugin.caseInsensitive = true
+				plugin.accentInsensitive = true
+				plugin.maxWildcards = 2
+				plugin.maxPatternLength = 20
+				plugin.queryFields = []string{"name"}
+			},
+		},
+		"pointer to options": {
+			setting: &QueryOptions{Character: "*"},
+			expected: func(plugin *gormLike) {
+				plugin.replaceCharacter = "*"
+			},
+		},
+		"nil pointer to options": {
+			options:  []Option{WithCharacter("*")},
+			setting:  (*QueryOptions)(nil),
+			expected: func(plugin *gormLike) {},
+		},
+		"empty options keep plugin options": {
+			options:  []Option{WithCharacter("*"), CaseInsensitive(), WithMaxWildcards(3)},
+			setting:  QueryOptions{},
+			expected: func(plugin *gormLike) {},
+		},
+		"options override plugin options": {
+			options: []Option{WithCharacter("*"), WithMaxWildcards(3)},
+			setting: QueryOptions{Character: "🍌", MaxWildcards: 1},
+			expected: func(plugin *gormLike) {
+				plugin.replaceCharacter = "🍌"
+				plugin.maxWildcards = 1
+			},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			if testData.setting != nil {
+				db = db.Set(queryOptionsSetting, testData.setting)
+			}
+
+			expected := *plugin
+			testData.expected(&expected)
+
+			// Act
+			result, err := plugin.withQueryOptions(db)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, &expected, result)
+			assert.Same(t, plugin.policies, result.policies)
+		})
+	}
+}
+
+func TestGormLike_WithQueryOptions_ReturnsErrorOnInvalidSetting(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	plugin, _ := New().(*gormLike)
+	db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name())).Set(queryOptionsSetting, "*")
+
+	// Act
+	result, err := plugin.withQueryOptions(db)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidQueryOptions)
+	assert.EqualError(t, err, "gormlike: invalid query options: expected gormlike.QueryOptions, got string")
+}
+
+func TestGormLike_Initialize_KeepsQueryOptionsOutOfReusedStatements(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name string
+	}
+
+	tests := map[string]struct {
+		options QueryOptions
+
+		expectedWithOptions []string
+	}{
+		"character": {
+			options:             QueryOptions{Character: "*"},
+			expectedWithOptions: []string{"JOHN", "Jo*", "jo*", "john"},
+		},
+		"character and case-insensitive": {
+			options:             QueryOptions{Character: "*", CaseInsensitive: true},
+			expectedWithOptions: []string{"JOHN", "Jo*", "jo*", "john"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			if err := db.CreateInBatches([]ObjectB{{Name: "jo*"}, {Name: "john"}, {Name: "JOHN"}, {Name: "Jo*"}}, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			err := db.Use(New())
+			assert.NoError(t, err)
+
+			base := db.Model(&ObjectB{}).Where(map[string]any{"name": "jo*"}).Order("name").Session(&gorm.Session{})
+
+			// Act
+			withoutOptionsBefore := []string{}
+			beforeErr := base.Pluck("name", &withoutOptionsBefore).Error
+
+			withOptions := []string{}
+			withErr := base.Set("gormlike:options", testData.options).Pluck("name", &withOptions).Error
+
+			withoutOptions := []string{}
+			withoutErr := base.Pluck("name", &withoutOptions).Error
+
+			sql := base.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Pluck("name", &[]string{})
+			})
+
+			// Assert
+			assert.NoError(t, beforeErr)
+			assert.NoError(t, withErr)
+			assert.NoError(t, withoutErr)
+
+			assert.Equal(t, []string{"jo*"}, withoutOptionsBefore)
+			assert.Equal(t, testData.expectedWithOptions, withOptions)
+			assert.Equal(t, []string{"jo*"}, withoutOptions)
+			assert.Equal(t, "SELECT `name` FROM `object_bs` WHERE `name` = \"jo*\" ORDER BY name", sql)
+		})
+	}
+}
+
+func TestGormLike_Initialize_UsesQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Email string
+		Star  string `gormlike:"char=🍌"`
+	}
+
+	tests := map[string]struct {
+		options  []Option
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+
+		expectedError error
+		expected      []ObjectB
+	}{
+		"character": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{Character: "*"}).Where(map[string]any{"name": "*ss*"})
+			},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+		"character is only used in the query it was set on": {
+			query: func(db *gorm.DB) *gorm.DB {
+				_ = db.Session(&gorm.Session{}).Set("gormlike:options", QueryOptions{Character: "*"}).Find(&[]ObjectB{})
+				return db.Where(map[string]any{"name": "*ss*"})
+			},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "*ss*"}},
+			expected: []ObjectB{{Name: "*ss*"}},
+		},
+		"character of tag takes precedence": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{Character: "*"}).Where(map[string]any{"star": "🍌ss🍌"})
+			},
+			existing: []ObjectB{{Star: "jessica"}, {Star: "amy"}},
+			expected: []ObjectB{{Star: "jessica"}},
+		},
+		"case-insensitive": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{CaseInsensitive: true}).Where(map[string]any{"name": "%SS%"})
+			},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+		"fields": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{Fields: []string{"name"}}).
+					Where(map[string]any{"name": "%ss%", "email": "%@%"})
+			},
+			existing: []ObjectB{{Name: "jessica", Email: "%@%"}, {Name: "jessica", Email: "jessica@example.com"}},
+			expected: []ObjectB{{Name: "jessica", Email: "%@%"}},
+		},
+		"fields in strict mode": {
+			options: []Option{Strict()},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{Fields: []string{"object_bs.name"}}).
+					Where(map[string]any{"email": "%@%"})
+			},
+			existing:      []ObjectB{{Name: "jessica", Email: "jessica@example.com"}},
+			expectedError: ErrFieldNotAllowed,
+		},
+		"max wildcards": {
+			options: []Option{WithMaxWildcards(3)},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{MaxWildcards: 1}).Where(map[string]any{"name": "%ss%"})
+			},
+			existing:      []ObjectB{{Name: "jessica"}},
+			expectedError: ErrTooManyWildcards,
+		},
+		"invalid options": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", map[string]any{"Character": "*"}).Where(map[string]any{"name": "*ss*"})
+			},
+			existing:      []ObjectB{{Name: "jessica"}},
+			expectedError: ErrInvalidQueryOptions,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = testData.query(db).Find(&actual).Error
+
+			if testData.expectedError != nil {
+				assert.ErrorIs(t, err, testData.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
@@ -1,12 +1,19 @@
 package gormlike
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/ing-bank/gormtestutil"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
 )
 
 type ObjectA struct {
@@ -17,7 +24,9 @@ type ObjectA struct {
 }
 
 func (object *ObjectA) BeforeCreate(tx *gorm.DB) (err error) {
-	object.ID, _ = uuid.NewUUID()
+	if object.ID == uuid.Nil {
+		object.ID, _ = uuid.NewUUID()
+	}
 	return
 }
 
@@ -199,6 +208,72 @@ func TestGormLike_Initialize_TriggersLikingCorrectly(t *testing.T) {
 			options:  []Option{WithCharacter("🍐")},
 		},
 
+		// With escaping
+		"underscores are not wildcards": {
+			filter: map[string]any{
+				"name": "%a_y",
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "a_y", Age: 53}, {Name: "amy", Age: 20}},
+			expected: []ObjectA{{Name: "a_y", Age: 53}},
+		},
+		"like query with escaped percentage": {
+			filter: map[string]any{
+				"name": `%0\%`,
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "100%", Age: 53}, {Name: "1000", Age: 20}},
+			expected: []ObjectA{{Name: "100%", Age: 53}},
+			options:  []Option{WithEscapeCharacter(`\`)},
+		},
+		"normal query with escaped percentage": {
+			filter: map[string]any{
+				"name": `100\%`,
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "100%", Age: 53}, {Name: "1000", Age: 20}},
+			expected: []ObjectA{{Name: "100%", Age: 53}},
+			options:  []Option{WithEscapeCharacter(`\`)},
+		},
+		"multi-value query with escaped replacement character": {
+			filter: map[string]any{
+				"name": []string{"!*", "j*"},
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "*", Age: 53}, {Name: "jessica", Age: 20}, {Name: "amy", Age: 25}},
+			expected: []ObjectA{{Name: "*", Age: 53}, {Name: "jessica", Age: 20}},
+			options:  []Option{WithCharacter("*"), WithEscapeCharacter("!")},
+		},
+		"multi-value query with only escaped values": {
+			filter: map[string]any{
+				"name": []string{"!*", "amy"},
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "*", Age: 53}, {Name: "jessica", Age: 20}, {Name: "amy", Age: 25}},
+			expected: []ObjectA{{Name: "*", Age: 53}, {Name: "amy", Age: 25}},
+			options:  []Option{WithCharacter("*"), WithEscapeCharacter("!")},
+		},
+
+		// With single-character replacement
+		"simple like query with single-character replacement": {
+			filter: map[string]any{
+				"name": "a?y",
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "amy", Age: 53}, {Name: "a_y", Age: 20}, {Name: "aimy", Age: 25}},
+			expected: []ObjectA{{Name: "amy", Age: 53}, {Name: "a_y", Age: 20}},
+			options:  []Option{WithSingleCharacter("?")},
+		},
+		"multi-value like query with single-character replacement": {
+			filter: map[string]any{
+				"name": []string{"a?y", "jes*", "j_hn"},
+			},
+			query:    defaultQuery,
+			existing: []ObjectA{{Name: "amy", Age: 53}, {Name: "jessica", Age: 20}, {Name: "john", Age: 25}},
+			expected: []ObjectA{{Name: "amy", Age: 53}, {Name: "jessica", Age: 20}},
+			options:  []Option{WithCharacter("*"), WithSingleCharacter("?")},
+		},
+
 		// With existing query
 		"simple like query with existing calls": {
 			filter: map[string]any{
@@ -307,46 +382,75 @@ func TestGormLike_Initialize_TriggersLikingCorrectly(t *testing.T) {
 	}
 }
 
-func TestGormLike_Initialize_TriggersLikingCorrectlyWithConditionalTag(t *testing.T) {
+func TestGormLike_Initialize_GeneratesCaseInsensitiveQueries(t *testing.T) {
 	t.Parallel()
 
 	type ObjectB struct {
-		Name  string `gormlike:"true"`
-		Other string
+		Name  string
+		Other string `gormlike:"ci"`
 	}
 
 	tests := map[string]struct {
 		filter   map[string]any
+		query    func(*gorm.DB) *gorm.DB
+		options  []Option
 		existing []ObjectB
-		expected []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
 	}{
-		"simple filter on allowed fields": {
-			filter: map[string]any{
-				"name": "jes%",
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
-			expected: []ObjectB{{Name: "jessica", Other: "abc"}},
+		"case-sensitive by default": {
+			filter:      map[string]any{"name": "JES%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name LIKE \"JES%\"",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}},
 		},
-		"simple filter on disallowed fields": {
-			filter: map[string]any{
-				"other": "%b%",
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+		"case-insensitive option": {
+			filter:      map[string]any{"name": "JES%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			options:     []Option{CaseInsensitive()},
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(name) LIKE LOWER(\"JES%\")",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}},
 		},
-		"multi-filter on allowed fields": {
-			filter: map[string]any{
-				"name": []string{"jes%", "%my"},
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
-			expected: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+		"case-insensitive option with multiple values": {
+			filter:      map[string]any{"name": []string{"JES%", "amy"}},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			options:     []Option{CaseInsensitive()},
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (LOWER(name) LIKE LOWER(\"JES%\") OR name = \"amy\")",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
 		},
-		"multi-filter on disallowed fields": {
-			filter: map[string]any{
-				"other": []string{"%b%", "%c%"},
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+		"case-insensitive option disabled in query": {
+			filter:      map[string]any{"name": "JES%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Set(caseInsensitiveSetting, false) },
+			options:     []Option{CaseInsensitive()},
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name LIKE \"JES%\"",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}},
+		},
+		"case-insensitive setting": {
+			filter:      map[string]any{"name": "JES%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Set(caseInsensitiveSetting, true) },
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(name) LIKE LOWER(\"JES%\")",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}},
+		},
+		"case-insensitive tag": {
+			filter:      map[string]any{"other": "%B%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(other) LIKE LOWER(\"%B%\")",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}},
+		},
+		"case-insensitive tag with tagged only": {
+			filter:      map[string]any{"other": "%B%"},
+			query:       func(db *gorm.DB) *gorm.DB { return db },
+			options:     []Option{TaggedOnly()},
+			existing:    []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(other) LIKE LOWER(\"%B%\")",
+			expected:    []ObjectB{{Name: "jessica", Other: "abc"}},
 		},
 	}
 
@@ -357,7 +461,77 @@ func TestGormLike_Initialize_TriggersLikingCorrectlyWithConditionalTag(t *testin
 			// Arrange
 			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
 			_ = db.AutoMigrate(&ObjectB{})
-			plugin := New(TaggedOnly())
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Where(testData.filter).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+
+			var actual []ObjectB
+			err = testData.query(db).Where(testData.filter).Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_CastsNonTextColumns(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name string
+		Age  int
+		Data []byte
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		existing []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
+	}{
+		"int column": {
+			filter:      map[string]any{"age": "2%"},
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 52}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE CAST(age AS TEXT) LIKE \"2%\"",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}},
+		},
+		"multi-value int column": {
+			filter:      map[string]any{"age": []any{"2%", 52}},
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 52}, {Name: "john", Age: 35}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (CAST(age AS TEXT) LIKE \"2%\" OR age = 52)",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 52}},
+		},
+		"bytes column is never LIKE-d": {
+			filter:      map[string]any{"data": "%a%"},
+			existing:    []ObjectB{{Name: "jessica", Data: []byte("%a%")}, {Name: "amy", Data: []byte("abc")}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `data` = \"%a%\"",
+			expected:    []ObjectB{},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New()
 
 			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
 				t.Error(err)
@@ -370,6 +544,11 @@ func TestGormLike_Initialize_TriggersLikingCorrectlyWithConditionalTag(t *testin
 			// Assert
 			assert.NoError(t, err)
 
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Where(testData.filter).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+
 			var actual []ObjectB
 			err = db.Where(testData.filter).Find(&actual).Error
 			assert.NoError(t, err)
@@ -379,39 +558,78 @@ func TestGormLike_Initialize_TriggersLikingCorrectlyWithConditionalTag(t *testin
 	}
 }
 
-func TestGormLike_Initialize_AlwaysIgnoresFieldsWithGormLikeFalse(t *testing.T) {
+func TestGormLike_Initialize_TriggersNotLikingCorrectly(t *testing.T) {
 	t.Parallel()
 
 	type ObjectB struct {
 		Name  string
+		Age   int
 		Other string `gormlike:"false"`
 	}
 
 	tests := map[string]struct {
-		filter   map[string]any
+		query    func(*gorm.DB) *gorm.DB
+		options  []Option
 		existing []ObjectB
-		expected []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
 	}{
-		"Normal filter works on never field": {
-			filter: map[string]any{
-				"other": "abc",
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+		"normal not query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "amy"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` <> \"amy\"",
+			expected:    []ObjectB{{Name: "jessica"}},
 		},
-		"simple filter on disallowed fields": {
-			filter: map[string]any{
-				"other": "%b%",
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+		"simple not like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name NOT LIKE \"%a%\"",
+			expected:    []ObjectB{{Name: "John"}},
 		},
-		"multi-filter on disallowed fields": {
-			filter: map[string]any{
-				"other": []string{"%b%", "%c%"},
-			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+		"more complex not like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "%a%", "age": 20}) },
+			existing:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 25}, {Name: "Jim", Age: 20}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`age` <> 20 AND name NOT LIKE \"%a%\")",
+			expected:    []ObjectB{{Name: "John", Age: 25}},
+		},
+		"multi-value not like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": []string{"%a%", "John"}}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}, {Name: "Jim"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE NOT (name LIKE \"%a%\" OR name = \"John\")",
+			expected:    []ObjectB{{Name: "Jim"}},
+		},
+		"case-insensitive not like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "%A%"}) },
+			options:     []Option{CaseInsensitive()},
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(name) NOT LIKE LOWER(\"%A%\")",
+			expected:    []ObjectB{{Name: "John"}},
+		},
+		"not like query with replacement character": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "*a*"}) },
+			options:     []Option{WithCharacter("*")},
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name NOT LIKE \"%a%\"",
+			expected:    []ObjectB{{Name: "John"}},
+		},
+		"neq like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(clause.Neq{Column: "name", Value: "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name NOT LIKE \"%a%\"",
+			expected:    []ObjectB{{Name: "John"}},
+		},
+		"not like query on disallowed field": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"other": "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica", Other: "%a%"}, {Name: "amy", Other: "abc"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `other` <> \"%a%\"",
+			expected:    []ObjectB{{Name: "amy", Other: "abc"}},
+		},
+		"not like query disabled in query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Set(tagName, false).Not(map[string]any{"name": "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "%a%"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` <> \"%a%\"",
+			expected:    []ObjectB{{Name: "jessica"}},
 		},
 	}
 
@@ -422,7 +640,7 @@ func TestGormLike_Initialize_AlwaysIgnoresFieldsWithGormLikeFalse(t *testing.T)
 			// Arrange
 			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
 			_ = db.AutoMigrate(&ObjectB{})
-			plugin := New(TaggedOnly())
+			plugin := New(testData.options...)
 
 			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
 				t.Error(err)
@@ -435,8 +653,13 @@ func TestGormLike_Initialize_AlwaysIgnoresFieldsWithGormLikeFalse(t *testing.T)
 			// Assert
 			assert.NoError(t, err)
 
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+
 			var actual []ObjectB
-			err = db.Where(testData.filter).Find(&actual).Error
+			err = testData.query(db).Find(&actual).Error
 			assert.NoError(t, err)
 
 			assert.Equal(t, testData.expected, actual)
@@ -444,59 +667,69 @@ func TestGormLike_Initialize_AlwaysIgnoresFieldsWithGormLikeFalse(t *testing.T)
 	}
 }
 
-func TestGormLike_Initialize_TriggersLikingCorrectlyWithSetting(t *testing.T) {
+func TestGormLike_Initialize_TriggersLikingInNestedConditions(t *testing.T) {
 	t.Parallel()
 
 	type ObjectB struct {
 		Name  string
-		Other string
+		Age   int
+		Other string `gormlike:"false"`
 	}
 
 	tests := map[string]struct {
-		filter   map[string]any
 		query    func(*gorm.DB) *gorm.DB
 		existing []ObjectB
-		expected []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
 	}{
-		"like with query set to true": {
-			filter: map[string]any{
-				"name": "jes%",
-			},
+		"or query": {
 			query: func(db *gorm.DB) *gorm.DB {
-				return db.Set(tagName, true)
+				return db.Where(map[string]any{"name": "jes%"}).Or(map[string]any{"name": "%my"})
 			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{{Name: "jessica", Other: "abc"}},
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name LIKE \"jes%\" OR name LIKE \"%my\"",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
 		},
-		"like with query set to false": {
-			filter: map[string]any{
-				"name": "jes%",
-			},
+		"or query with multiple values": {
 			query: func(db *gorm.DB) *gorm.DB {
-				return db.Set(tagName, false)
+				return db.Where("age = ?", 20).Or(map[string]any{"name": []string{"jes%", "John"}})
 			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+			existing:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 25}, {Name: "Jim", Age: 30}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE age = 20 OR (name LIKE \"jes%\" OR name = \"John\")",
+			expected:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 25}},
 		},
-		"like with query set to random value": {
-			filter: map[string]any{
-				"name": "jes%",
+		"grouped conditions": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Where(db.Where(map[string]any{"name": "%a%"}).Where("age > ?", 30)).Or(map[string]any{"name": "J%"})
 			},
+			existing:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 25}, {Name: "bob", Age: 30}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (name LIKE \"%a%\" AND age > 30) OR name LIKE \"J%\"",
+			expected:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "John", Age: 25}},
+		},
+		"deeply nested conditions": {
 			query: func(db *gorm.DB) *gorm.DB {
-				return db.Set(tagName, "yes")
+				return db.Where(clause.And(clause.Or(clause.And(clause.Eq{Column: "name", Value: "%ss%"}, clause.Eq{Column: "age", Value: 53}), clause.Eq{Column: "name", Value: "a%"})))
 			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+			existing:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 25}, {Name: "bob", Age: 30}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE ((name LIKE \"%ss%\" AND `age` = 53) OR name LIKE \"a%\")",
+			expected:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}},
 		},
-		"like with query unset": {
-			filter: map[string]any{
-				"name": "jes%",
+		"negated grouped conditions": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Not(db.Where(map[string]any{"name": "%a%"}).Or(map[string]any{"name": "J%"}))
 			},
+			existing:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 25}, {Name: "bob", Age: 30}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE NOT (name LIKE \"%a%\" OR name LIKE \"J%\")",
+			expected:    []ObjectB{{Name: "bob", Age: 30}},
+		},
+		"nested condition on disallowed field": {
 			query: func(db *gorm.DB) *gorm.DB {
-				return db
+				return db.Where("age = ?", 20).Or(map[string]any{"other": "%a%"})
 			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+			existing:    []ObjectB{{Name: "jessica", Age: 53, Other: "%a%"}, {Name: "amy", Age: 20, Other: "abc"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE age = 20 OR `other` = \"%a%\"",
+			expected:    []ObjectB{{Name: "jessica", Age: 53, Other: "%a%"}, {Name: "amy", Age: 20, Other: "abc"}},
 		},
 	}
 
@@ -507,23 +740,26 @@ func TestGormLike_Initialize_TriggersLikingCorrectlyWithSetting(t *testing.T) {
 			// Arrange
 			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
 			_ = db.AutoMigrate(&ObjectB{})
-			plugin := New(SettingOnly())
+			plugin := New()
 
 			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
 				t.Error(err)
 				t.FailNow()
 			}
 
-			db = testData.query(db)
-
 			// Act
 			err := db.Use(plugin)
 
 			// Assert
 			assert.NoError(t, err)
 
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+
 			var actual []ObjectB
-			err = db.Where(testData.filter).Find(&actual).Error
+			err = testData.query(db).Find(&actual).Error
 			assert.NoError(t, err)
 
 			assert.Equal(t, testData.expected, actual)
@@ -531,30 +767,79 @@ func TestGormLike_Initialize_TriggersLikingCorrectlyWithSetting(t *testing.T) {
 	}
 }
 
-func TestGormLike_Initialize_ProcessUnknownFields(t *testing.T) {
+func TestGormLike_Initialize_TriggersLikingInOtherOperations(t *testing.T) {
 	t.Parallel()
 
 	type ObjectB struct {
-		Name  string
-		Other string
+		ID   int
+		Name string
+		Age  int
 	}
 
 	tests := map[string]struct {
-		filter   map[string]any
-		query    func(*gorm.DB) *gorm.DB
-		existing []ObjectB
+		operation func(*gorm.DB) error
+		options   []Option
+		existing  []ObjectB
+
 		expected []ObjectB
 	}{
-		"like with unknown field": {
-			filter: map[string]any{
-				"name":          "jes%",
-				"unknown_field": false,
+		"update without option": {
+			operation: func(db *gorm.DB) error {
+				return db.Model(&ObjectB{}).Where(map[string]any{"name": "%a%"}).Update("age", 1).Error
 			},
-			query: func(db *gorm.DB) *gorm.DB {
-				return db.Set(tagName, true)
+			existing: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+			expected: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+		},
+		"update": {
+			operation: func(db *gorm.DB) error {
+				return db.Model(&ObjectB{}).Where(map[string]any{"name": "%a%"}).Update("age", 1).Error
 			},
-			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
-			expected: []ObjectB{},
+			options:  []Option{OnUpdate()},
+			existing: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+			expected: []ObjectB{{ID: 1, Name: "jessica", Age: 1}, {ID: 2, Name: "John", Age: 25}},
+		},
+		"delete without option": {
+			operation: func(db *gorm.DB) error {
+				return db.Where(map[string]any{"name": "%a%"}).Delete(&ObjectB{}).Error
+			},
+			existing: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+			expected: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+		},
+		"delete": {
+			operation: func(db *gorm.DB) error {
+				return db.Where(map[string]any{"name": "%a%"}).Delete(&ObjectB{}).Error
+			},
+			options:  []Option{OnDelete()},
+			existing: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+			expected: []ObjectB{{ID: 2, Name: "John", Age: 25}},
+		},
+		"row": {
+			operation: func(db *gorm.DB) error {
+				var name string
+				if err := db.Model(&ObjectB{}).Where(map[string]any{"name": "%a%"}).Select("name").Row().Scan(&name); err != nil {
+					return err
+				}
+
+				// Update the record to prove that the row was found
+				return db.Model(&ObjectB{}).Where("name = ?", name).Update("age", 1).Error
+			},
+			options:  []Option{OnRow()},
+			existing: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+			expected: []ObjectB{{ID: 1, Name: "jessica", Age: 1}, {ID: 2, Name: "John", Age: 25}},
+		},
+		"row without schema": {
+			operation: func(db *gorm.DB) error {
+				var name string
+				if err := db.Table("object_bs").Where(map[string]any{"name": "%a%"}).Select("name").Row().Scan(&name); err != nil {
+					return err
+				}
+
+				// Update the record to prove that the row was found
+				return db.Model(&ObjectB{}).Where("name = ?", name).Update("age", 1).Error
+			},
+			options:  []Option{OnRow()},
+			existing: []ObjectB{{ID: 1, Name: "jessica", Age: 53}, {ID: 2, Name: "John", Age: 25}},
+			expected: []ObjectB{{ID: 1, Name: "jessica", Age: 1}, {ID: 2, Name: "John", Age: 25}},
 		},
 	}
 
@@ -565,25 +850,1642 @@ func TestGormLike_Initialize_ProcessUnknownFields(t *testing.T) {
 			// Arrange
 			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
 			_ = db.AutoMigrate(&ObjectB{})
-			plugin := New(SettingOnly())
+			plugin := New(testData.options...)
 
 			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
 				t.Error(err)
 				t.FailNow()
 			}
 
-			db = testData.query(db)
-
 			// Act
 			err := db.Use(plugin)
 
 			// Assert
 			assert.NoError(t, err)
 
+			err = testData.operation(db)
+			assert.NoError(t, err)
+
 			var actual []ObjectB
-			err = db.Where(testData.filter).Find(&actual).Error
-			assert.Equal(t, "no such column: unknown_field", err.Error())
-			assert.Nil(t, actual)
+			err = db.Order("id").Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_TriggersLikingWithStructConditions(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Age   int
+		Other string `gormlike:"false"`
+	}
+
+	tests := map[string]struct {
+		query    func(*gorm.DB) *gorm.DB
+		options  []Option
+		existing []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
+	}{
+		"normal struct query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(&ObjectB{Name: "amy"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`name` = \"amy\"",
+			expected:    []ObjectB{{Name: "amy"}},
+		},
+		"simple struct like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(&ObjectB{Name: "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`name` LIKE \"%a%\"",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+		},
+		"more complex struct like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(&ObjectB{Name: "%a%", Age: 20}) },
+			existing:    []ObjectB{{Name: "jessica", Age: 53}, {Name: "amy", Age: 20}, {Name: "John", Age: 20}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`name` LIKE \"%a%\" AND `object_bs`.`age` = 20",
+			expected:    []ObjectB{{Name: "amy", Age: 20}},
+		},
+		"struct like query with replacement character": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(&ObjectB{Name: "*a*"}) },
+			options:     []Option{WithCharacter("*")},
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`name` LIKE \"%a%\"",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+		},
+		"negated struct like query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(&ObjectB{Name: "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`name` NOT LIKE \"%a%\"",
+			expected:    []ObjectB{{Name: "John"}},
+		},
+		"struct like query on disallowed field": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(&ObjectB{Other: "%a%"}) },
+			existing:    []ObjectB{{Name: "jessica", Other: "%a%"}, {Name: "amy", Other: "abc"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`other` = \"%a%\"",
+			expected:    []ObjectB{{Name: "jessica", Other: "%a%"}},
+		},
+		"struct like query on disallowed field with tagged only": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(&ObjectB{Name: "%a%"}) },
+			options:     []Option{TaggedOnly()},
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "%a%"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `object_bs`.`name` = \"%a%\"",
+			expected:    []ObjectB{{Name: "%a%"}},
+		},
+		"multi-value column query": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Where(clause.IN{Column: clause.Column{Table: clause.CurrentTable, Name: "name"}, Values: []any{"%a%", "John"}})
+			},
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}, {Name: "Jim"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`object_bs`.`name` LIKE \"%a%\" OR `object_bs`.`name` = \"John\")",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}, {Name: "John"}},
+		},
+		"multi-value column query on disallowed field": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Where(clause.IN{Column: clause.Column{Name: "other"}, Values: []any{"%a%", "abc"}})
+			},
+			existing:    []ObjectB{{Name: "jessica", Other: "%a%"}, {Name: "amy", Other: "abc"}, {Name: "John", Other: "def"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `other` IN (\"%a%\",\"abc\")",
+			expected:    []ObjectB{{Name: "jessica", Other: "%a%"}, {Name: "amy", Other: "abc"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+
+			var actual []ObjectB
+			err = testData.query(db).Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_TriggersLikingOnJoinedColumns(t *testing.T) {
+	t.Parallel()
+
+	type Company struct {
+		ID      int
+		Name    string `gormlike:"false"`
+		Code    string `gormlike:"true"`
+		Founded int
+	}
+
+	type Employee struct {
+		ID        int
+		Name      string
+		CompanyID int
+		Company   Company
+	}
+
+	companies := []Company{{ID: 1, Name: "%acme%", Code: "ac", Founded: 1950}, {ID: 2, Name: "globex", Code: "gl", Founded: 1989}}
+	employees := []Employee{{ID: 1, Name: "jessica", CompanyID: 1}, {ID: 2, Name: "amy", CompanyID: 2}, {ID: 3, Name: "John", CompanyID: 2}}
+
+	tests := map[string]struct {
+		query   func(*gorm.DB) *gorm.DB
+		options []Option
+
+		expectedSQL string
+		expected    []string
+	}{
+		"qualified column of own table": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Joins("Company").Where(map[string]any{"employees.name": "%a%"})
+			},
+			expectedSQL: "employees.name LIKE \"%a%\"",
+			expected:    []string{"jessica", "amy"},
+		},
+		"column of joined relationship": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Joins("Company").Where(map[string]any{"Company.code": "g%"})
+			},
+			options:     []Option{TaggedOnly()},
+			expectedSQL: "Company.code LIKE \"g%\"",
+			expected:    []string{"amy", "John"},
+		},
+		"column of joined relationship with disallowed tag": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Joins("Company").Where(map[string]any{"Company.name": "%acme%"})
+			},
+			expectedSQL: "`Company`.`name` = \"%acme%\"",
+			expected:    []string{"jessica"},
+		},
+		"column of joined relationship by table name": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Joins("JOIN companies ON companies.id = employees.company_id").Where(map[string]any{"companies.name": "%acme%"})
+			},
+			expectedSQL: "`companies`.`name` = \"%acme%\"",
+			expected:    []string{"jessica"},
+		},
+		"non-text column of joined relationship": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Joins("Company").Where(map[string]any{"Company.founded": "19_9"})
+			},
+			options:     []Option{WithSingleCharacter("_")},
+			expectedSQL: "CAST(Company.founded AS TEXT) LIKE \"19_9\"",
+			expected:    []string{"amy", "John"},
+		},
+		"qualified column of joined relationship": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Joins("Company").Where(clause.Eq{Column: clause.Column{Table: "Company", Name: "code"}, Value: "a%"})
+			},
+			options:     []Option{TaggedOnly()},
+			expectedSQL: "`Company`.`code` LIKE \"a%\"",
+			expected:    []string{"jessica"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&Company{}, &Employee{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(companies, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if err := db.Omit("Company").CreateInBatches(employees, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]Employee{})
+			})
+			assert.Contains(t, sql, testData.expectedSQL)
+
+			var actual []Employee
+			err = testData.query(db).Order("employees.id").Find(&actual).Error
+			assert.NoError(t, err)
+
+			names := make([]string, len(actual))
+			for index, employee := range actual {
+				names[index] = employee.Name
+			}
+
+			assert.Equal(t, testData.expected, names)
+		})
+	}
+}
+
+func TestGormLike_Initialize_LimitsPatterns(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string `gormlike:"max=1;noleading"`
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		options  []Option
+		existing []ObjectB
+
+		expectedError error
+		expected      []ObjectB
+	}{
+		"within limits": {
+			filter:   map[string]any{"name": "jes%"},
+			options:  []Option{WithMaxWildcards(2), WithMaxPatternLength(5), WithForbidLeadingWildcard()},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+		"too many wildcards": {
+			filter:        map[string]any{"name": "%%%%a%%%%"},
+			options:       []Option{WithMaxWildcards(2)},
+			existing:      []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedError: ErrTooManyWildcards,
+		},
+		"too many wildcards in multi-value query": {
+			filter:        map[string]any{"name": []string{"amy", "%%%%a%%%%"}},
+			options:       []Option{WithMaxWildcards(2)},
+			existing:      []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedError: ErrTooManyWildcards,
+		},
+		"pattern too long": {
+			filter:        map[string]any{"name": "jessica%"},
+			options:       []Option{WithMaxPatternLength(5)},
+			existing:      []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedError: ErrPatternTooLong,
+		},
+		"leading wildcard": {
+			filter:        map[string]any{"name": "%ica"},
+			options:       []Option{WithForbidLeadingWildcard()},
+			existing:      []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedError: ErrLeadingWildcard,
+		},
+		"leading wildcard in tag": {
+			filter:        map[string]any{"other": "%bc"},
+			existing:      []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy"}},
+			expectedError: ErrLeadingWildcard,
+		},
+		"too many wildcards in tag": {
+			filter:        map[string]any{"other": "a%c%"},
+			options:       []Option{WithMaxWildcards(3)},
+			existing:      []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy"}},
+			expectedError: ErrTooManyWildcards,
+		},
+		"degraded pattern": {
+			filter:   map[string]any{"name": "%ica"},
+			options:  []Option{WithForbidLeadingWildcard(), DegradeRejectedPatterns()},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "%ica"}},
+			expected: []ObjectB{{Name: "%ica"}},
+		},
+		"degraded pattern in multi-value query": {
+			filter:   map[string]any{"name": []string{"%ica", "a%"}},
+			options:  []Option{WithForbidLeadingWildcard(), DegradeRejectedPatterns()},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "%ica"}, {Name: "amy"}},
+			expected: []ObjectB{{Name: "%ica"}, {Name: "amy"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+
+			if testData.expectedError != nil {
+				assert.ErrorIs(t, err, testData.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_UsesTagSettings(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name   string
+		Prefix string `gormlike:"prefix-only"`
+		Star   string `gormlike:"true;ci;char=*"`
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		options  []Option
+		existing []ObjectB
+
+		expectedError error
+		expected      []ObjectB
+	}{
+		"prefix pattern on prefix-only field": {
+			filter:   map[string]any{"prefix": "jes%"},
+			existing: []ObjectB{{Prefix: "jessica"}, {Prefix: "amy"}},
+			expected: []ObjectB{{Prefix: "jessica"}},
+		},
+		"other pattern on prefix-only field": {
+			filter:        map[string]any{"prefix": "%ica"},
+			existing:      []ObjectB{{Prefix: "jessica"}, {Prefix: "amy"}},
+			expectedError: ErrPatternShape,
+		},
+		"degraded pattern on prefix-only field": {
+			filter:   map[string]any{"prefix": "%ica"},
+			options:  []Option{DegradeRejectedPatterns()},
+			existing: []ObjectB{{Prefix: "jessica"}, {Prefix: "%ica"}},
+			expected: []ObjectB{{Prefix: "%ica"}},
+		},
+		"replacement character of tag": {
+			filter:   map[string]any{"star": "*SIC*"},
+			options:  []Option{WithCharacter("?")},
+			existing: []ObjectB{{Star: "jessica"}, {Star: "amy"}},
+			expected: []ObjectB{{Star: "jessica"}},
+		},
+		"replacement character of tag in multi-value query": {
+			filter:   map[string]any{"star": []string{"amy", "*SIC*"}},
+			existing: []ObjectB{{Star: "jessica"}, {Star: "amy"}, {Star: "john"}},
+			expected: []ObjectB{{Star: "jessica"}, {Star: "amy"}},
+		},
+		"replacement character of option is not used for tagged field": {
+			filter:   map[string]any{"star": "?sic?"},
+			options:  []Option{WithCharacter("?")},
+			existing: []ObjectB{{Star: "jessica"}, {Star: "?sic?"}},
+			expected: []ObjectB{{Star: "?sic?"}},
+		},
+		"replacement character of option is used for other fields": {
+			filter:   map[string]any{"name": "?sic?"},
+			options:  []Option{WithCharacter("?")},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+
+			if testData.expectedError != nil {
+				assert.ErrorIs(t, err, testData.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_UsesFieldOptions(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name   string
+		Email  string
+		Tagged string `gormlike:"true"`
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		options  []Option
+		existing []ObjectB
+
+		expectedError error
+		expected      []ObjectB
+	}{
+		"listed field with WithFields": {
+			filter:   map[string]any{"email": "%@example.com"},
+			options:  []Option{WithFields("object_bs.email")},
+			existing: []ObjectB{{Email: "jessica@example.com"}, {Email: "amy@example.org"}},
+			expected: []ObjectB{{Email: "jessica@example.com"}},
+		},
+		"other field with WithFields": {
+			filter:   map[string]any{"name": "%a%"},
+			options:  []Option{WithFields("object_bs.email")},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "%a%"}},
+			expected: []ObjectB{{Name: "%a%"}},
+		},
+		"tagged field with WithFields": {
+			filter:   map[string]any{"tagged": "%a%"},
+			options:  []Option{WithFields("object_bs.email")},
+			existing: []ObjectB{{Tagged: "jessica"}, {Tagged: "john"}},
+			expected: []ObjectB{{Tagged: "jessica"}},
+		},
+		"other field with WithFields in strict mode": {
+			filter:        map[string]any{"name": "%a%"},
+			options:       []Option{WithFields("email"), Strict()},
+			existing:      []ObjectB{{Name: "jessica"}},
+			expectedError: ErrFieldNotAllowed,
+		},
+		"listed field with WithFields and TaggedOnly": {
+			filter:   map[string]any{"email": "%@example.com"},
+			options:  []Option{TaggedOnly(), WithFields("ObjectB.Email")},
+			existing: []ObjectB{{Email: "jessica@example.com"}, {Email: "amy@example.org"}},
+			expected: []ObjectB{{Email: "jessica@example.com"}},
+		},
+		"listed field with WithoutFields": {
+			filter:   map[string]any{"email": "%@example.com"},
+			options:  []Option{WithoutFields("object_bs.email")},
+			existing: []ObjectB{{Email: "jessica@example.com"}, {Email: "%@example.com"}},
+			expected: []ObjectB{{Email: "%@example.com"}},
+		},
+		"other field with WithoutFields": {
+			filter:   map[string]any{"name": "%a%"},
+			options:  []Option{WithoutFields("object_bs.email")},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "john"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+		"field of predicate with WithoutFieldsFunc": {
+			filter: map[string]any{"email": "%@example.com"},
+			options: []Option{WithoutFieldsFunc(func(field *schema.Field) bool {
+				return field.Name == "Email"
+			})},
+			existing: []ObjectB{{Email: "jessica@example.com"}, {Email: "%@example.com"}},
+			expected: []ObjectB{{Email: "%@example.com"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+
+			if testData.expectedError != nil {
+				assert.ErrorIs(t, err, testData.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_FailsQueriesOnInvalidTags(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string `gormlike:"true;maxlen=many"`
+	}
+
+	tests := map[string]struct {
+		query func(*gorm.DB) *gorm.DB
+	}{
+		"without conditions": {
+			query: func(db *gorm.DB) *gorm.DB { return db },
+		},
+		"with condition on other field": {
+			query: func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "%a%"}) },
+		},
+		"with condition on invalid field": {
+			query: func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "%a%"}) },
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New()
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = testData.query(db).Find(&actual).Error
+
+			assert.ErrorIs(t, err, ErrInvalidTag)
+			assert.ErrorContains(t, err, `setting "maxlen=many" must be a positive number on field ObjectB.Other`)
+		})
+	}
+}
+
+func TestGormLike_Initialize_ReportsErrorsInStrictMode(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string `gormlike:"false"`
+		Data  []byte
+	}
+
+	pattern := "%a%"
+
+	tests := map[string]struct {
+		query    func(*gorm.DB) *gorm.DB
+		options  []Option
+		existing []ObjectB
+
+		expectedError error
+		expected      []ObjectB
+	}{
+		"like query": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "%a%"}) },
+			options:  []Option{Strict()},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "John"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+		"like query with string pointer": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": &pattern}) },
+			options:  []Option{Strict()},
+			existing: []ObjectB{{Name: "jessica"}, {Name: "John"}},
+			expected: []ObjectB{{Name: "jessica"}},
+		},
+		"unknown field": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"unknown": "%a%"}) },
+			options:       []Option{Strict()},
+			expectedError: ErrUnknownField,
+		},
+		"unknown field in nested condition": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where("name = ?", "amy").Or(map[string]any{"unknown": "%a%"}) },
+			options:       []Option{Strict()},
+			expectedError: ErrUnknownField,
+		},
+		"disabled field": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "%a%"}) },
+			options:       []Option{Strict()},
+			expectedError: ErrTagForbidden,
+		},
+		"disabled field without wildcards": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "abc"}) },
+			options:  []Option{Strict()},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "John"}},
+			expected: []ObjectB{{Name: "jessica", Other: "abc"}},
+		},
+		"disabled field in multi-value query": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": []string{"abc", "%a%"}}) },
+			options:       []Option{Strict()},
+			expectedError: ErrTagForbidden,
+		},
+		"untagged field with tagged only": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "%a%"}) },
+			options:       []Option{Strict(), TaggedOnly()},
+			expectedError: ErrTagForbidden,
+		},
+		"not like-able field": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"data": "%a%"}) },
+			options:       []Option{Strict()},
+			expectedError: ErrNotLikeable,
+		},
+		"disabled field without strict mode": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "%a%"}) },
+			existing: []ObjectB{{Name: "jessica", Other: "%a%"}, {Name: "John", Other: "abc"}},
+			expected: []ObjectB{{Name: "jessica", Other: "%a%"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(testData.options...)
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = testData.query(db).Find(&actual).Error
+
+			if testData.expectedError != nil {
+				assert.ErrorIs(t, err, testData.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_OptimizesPrefixPatterns(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name string
+		Age  int
+	}
+
+	tests := map[string]struct {
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
+	}{
+		"prefix pattern": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "jes%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "jes"}, {Name: "jet"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (name >= \"jes\" AND name < \"jet\")",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "jes"}},
+		},
+		"multi-byte prefix pattern": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "josé%"}) },
+			existing:    []ObjectB{{Name: "josé"}, {Name: "josée"}, {Name: "josf"}, {Name: "jose"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (name >= \"josé\" AND name < \"josê\")",
+			expected:    []ObjectB{{Name: "josé"}, {Name: "josée"}},
+		},
+		"negated prefix pattern": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "jes%"}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "jes"}, {Name: "jet"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (name < \"jes\" OR name >= \"jet\")",
+			expected:    []ObjectB{{Name: "jet"}, {Name: "amy"}},
+		},
+		"multi-value prefix pattern": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": []string{"jes%", "%my"}}) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "jet"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (((name >= \"jes\" AND name < \"jet\")) OR name LIKE \"%my\")",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+		},
+		"prefix pattern on cast column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"age": "2%"}) },
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 52}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE CAST(age AS TEXT) LIKE \"2%\"",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(OptimizePrefixPatterns())
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+
+			var actual []ObjectB
+			err = testData.query(db).Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_GeneratesRegexQueries(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name   string
+		Age    int
+		Other  string `gormlike:"ci"`
+		Prefix string `gormlike:"prefix-only"`
+		Nope   string `gormlike:"false"`
+	}
+
+	tests := map[string]struct {
+		query   func(*gorm.DB) *gorm.DB
+		options []Option
+
+		expectedSQL   string
+		expectedError error
+	}{
+		"regular expression": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^j.*a$/"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name REGEXP \"^j.*a$\"",
+		},
+		"negated regular expression": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "/^j/"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name NOT REGEXP \"^j\"",
+		},
+		"regular expression on cast column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"age": "/^4/"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE CAST(age AS TEXT) REGEXP \"^4\"",
+		},
+		"case-insensitive tag": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "/^j/"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE other REGEXP \"(?i)^j\"",
+		},
+		"multi-value query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": []string{"amy", "/^j/", "%e%"}}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (name = \"amy\" OR name REGEXP \"^j\" OR name LIKE \"%e%\")",
+		},
+		"wildcards are taken literally": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^100%$/"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name REGEXP \"^100%$\"",
+		},
+		"other delimiter": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^j/"}) },
+			options:     []Option{WithRegexDelimiter("~")},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` = \"/^j/\"",
+		},
+		"disabled field": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"nope": "/^j/"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `nope` = \"/^j/\"",
+		},
+		"untagged field with tagged only": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^j/"}) },
+			options:     []Option{TaggedOnly()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` = \"/^j/\"",
+		},
+		"tagged field with tagged only": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "/^j/"}) },
+			options:     []Option{TaggedOnly()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE other REGEXP \"(?i)^j\"",
+		},
+		"without setting": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^j/"}) },
+			options:     []Option{SettingOnly()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` = \"/^j/\"",
+		},
+		"with setting": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Set("gormlike", true).Where(map[string]any{"name": "/^j/"}) },
+			options:     []Option{SettingOnly()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE name REGEXP \"^j\"",
+		},
+		"degraded unsafe expression": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/(a+)+$/"}) },
+			options:     []Option{DegradeRejectedPatterns()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` = \"/(a+)+$/\"",
+		},
+		"invalid expression": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/(/"}) },
+			expectedError: ErrInvalidRegex,
+		},
+		"unsafe expression": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/(a+)+$/"}) },
+			expectedError: ErrUnsafeRegex,
+		},
+		"unsafe expression in multi-value query": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": []string{"amy", "/(a*)*/"}}) },
+			expectedError: ErrUnsafeRegex,
+		},
+		"too long expression": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^jessica/"}) },
+			options:       []Option{WithMaxPatternLength(4)},
+			expectedError: ErrPatternTooLong,
+		},
+		"prefix-only field": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"prefix": "/^j/"}) },
+			expectedError: ErrPatternShape,
+		},
+		"unknown field in strict mode": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"unknown": "/^j/"}) },
+			options:       []Option{Strict()},
+			expectedError: ErrUnknownField,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(append([]Option{WithRegexDelimiter("/")}, testData.options...)...)
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			if testData.expectedError != nil {
+				err = testData.query(db).Find(&[]ObjectB{}).Error
+				assert.ErrorIs(t, err, testData.expectedError)
+
+				return
+			}
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
+
+// likeMatches is a reference implementation of a case-sensitive LIKE, like the one of Postgres
+func likeMatches(pattern string, value string) bool {
+	var expression strings.Builder
+	expression.WriteString("(?s)^")
+
+	for index := 0; index < len(pattern); index++ {
+		switch pattern[index] {
+		case '\\':
+			index++
+			expression.WriteString(regexp.QuoteMeta(pattern[index : index+1]))
+		case '%':
+			expression.WriteString(".*")
+		case '_':
+			expression.WriteString(".")
+		default:
+			expression.WriteString(regexp.QuoteMeta(pattern[index : index+1]))
+		}
+	}
+
+	expression.WriteString("$")
+
+	return regexp.MustCompile(expression.String()).MatchString(value)
+}
+
+func TestGormLike_Initialize_MatchesLikePostgresWithSQLiteGlob(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name string
+	}
+
+	existing := []ObjectB{
+		{Name: "jessica"}, {Name: "Jessica"}, {Name: "JESSICA"}, {Name: "jes"}, {Name: "je"}, {Name: "amy"},
+		{Name: "100%"}, {Name: "100%!"}, {Name: "1000"}, {Name: "a_b"}, {Name: "axb"}, {Name: "a*b"}, {Name: "a?b"},
+		{Name: "[x]"}, {Name: "]x"}, {Name: "x[y"}, {Name: "josé"}, {Name: "JOSÉ"}, {Name: "🍌"}, {Name: "🍌🍌"},
+		{Name: ""}, {Name: "multi\nline"},
+	}
+
+	values := []string{
+		"jes%", "Jes%", "%SIC%", "%ica", "j_s%", "%", "_", "__", "100!%", "100!%%", "100%", "a!_b", "a_b", "a*b",
+		"a?b", "[x]", "[%", "%]%", "%[%", "%é", "%É", "🍌", "🍌_", "%\n%", "josé%", "amy", "AMY",
+	}
+
+	for index, value := range values {
+		value := value
+		// The name of the test is used in the name of the database, which can't contain all characters
+		t.Run(strconv.Itoa(index), func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(SQLiteGlob(), WithEscapeCharacter("!"))
+
+			if err := db.CreateInBatches(existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			pattern, _, _ := plugin.(*gormLike).convertValue(value, "")
+
+			expected, expectedNot := []string{}, []string{}
+
+			for _, object := range existing {
+				if likeMatches(pattern, object.Name) {
+					expected = append(expected, object.Name)
+				} else {
+					expectedNot = append(expectedNot, object.Name)
+				}
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			actual, actualNot := []string{}, []string{}
+			assert.NoError(t, db.Model(&ObjectB{}).Where(map[string]any{"name": value}).Pluck("name", &actual).Error)
+			assert.NoError(t, db.Model(&ObjectB{}).Not(map[string]any{"name": value}).Pluck("name", &actualNot).Error)
+
+			assert.Equal(t, expected, actual, value)
+			assert.Equal(t, expectedNot, actualNot, value)
+		})
+	}
+}
+
+func TestGormLike_Initialize_GeneratesFullTextQueries(t *testing.T) {
+	t.Parallel()
+
+	type Article struct {
+		Title string
+		Body  string `gormlike:"fts;ci;maxlen=10"`
+	}
+
+	tests := map[string]struct {
+		query   func(*gorm.DB) *gorm.DB
+		options []Option
+
+		expectedSQL   string
+		expectedError error
+	}{
+		"full-text search": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "%gorm%"}) },
+			expectedSQL: "SELECT * FROM `articles` WHERE `articles`.rowid IN (SELECT rowid FROM `articles` WHERE `body` MATCH \"\\\"gorm\\\"\")",
+		},
+		"negated full-text search": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"body": "%gorm%"}) },
+			expectedSQL: "SELECT * FROM `articles` WHERE NOT (`articles`.rowid IN (SELECT rowid FROM `articles` WHERE `body` MATCH \"\\\"gorm\\\"\"))",
+		},
+		"multi-value full-text search": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": []string{"gorm", "%sql%"}}) },
+			expectedSQL: "SELECT * FROM `articles` WHERE (body = \"gorm\" OR `articles`.rowid IN (SELECT rowid FROM `articles` WHERE `body` MATCH \"\\\"sql\\\"\"))",
+		},
+		"no wildcards": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "gorm"}) },
+			expectedSQL: "SELECT * FROM `articles` WHERE `body` = \"gorm\"",
+		},
+		"no words falls back to like": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "%"}) },
+			expectedSQL: "SELECT * FROM `articles` WHERE LOWER(body) LIKE LOWER(\"%\")",
+		},
+		"untagged field": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"title": "%gorm%"}) },
+			expectedSQL: "SELECT * FROM `articles` WHERE title LIKE \"%gorm%\"",
+		},
+		"without setting": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "%gorm%"}) },
+			options:     []Option{SettingOnly()},
+			expectedSQL: "SELECT * FROM `articles` WHERE `body` = \"%gorm%\"",
+		},
+		"limits of the tag apply": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"body": "%gorm plugins%"}) },
+			expectedError: ErrPatternTooLong,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&Article{})
+			plugin := New(testData.options...)
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			if testData.expectedError != nil {
+				err = testData.query(db).Find(&[]Article{}).Error
+				assert.ErrorIs(t, err, testData.expectedError)
+
+				return
+			}
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]Article{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
+
+func TestGormLike_Initialize_GeneratesFuzzyQueries(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name   string
+		Other  string `gormlike:"fuzzy=0.6"`
+		Prefix string `gormlike:"prefix-only"`
+	}
+
+	tests := map[string]struct {
+		query   func(*gorm.DB) *gorm.DB
+		options []Option
+
+		expectedSQL   string
+		expectedError error
+	}{
+		"fuzzy value": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonh"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.300000",
+		},
+		"threshold of option": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonh"}) },
+			options:     []Option{WithFuzzyThreshold(0.4)},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.400000",
+		},
+		"threshold of tag": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "~jonh"}) },
+			options:     []Option{WithFuzzyThreshold(0.4)},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(other, \"jonh\") > 0.600000",
+		},
+		"wildcards are taken literally": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jo%"}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(name, \"jo%\") > 0.300000",
+		},
+		"multi-value query": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": []string{"amy", "~jonh"}}) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (name = \"amy\" OR similarity(name, \"jonh\") > 0.300000)",
+		},
+		"ordered by similarity": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonh"}) },
+			options:     []Option{OrderByFuzzySimilarity()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.300000 ORDER BY similarity(name, \"jonh\") DESC",
+		},
+		"ordered after existing order": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Order("other").Where(map[string]any{"name": "~jonh"}) },
+			options:     []Option{OrderByFuzzySimilarity()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.300000 ORDER BY other, similarity(name, \"jonh\") DESC",
+		},
+		"ordered by multiple fuzzy values": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Where(map[string]any{"name": "~jonh"}).Or(map[string]any{"other": "~amy"})
+			},
+			options:     []Option{OrderByFuzzySimilarity()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.300000 OR similarity(other, \"amy\") > 0.600000 ORDER BY similarity(name, \"jonh\") DESC, similarity(other, \"amy\") DESC",
+		},
+		"negated values are not ordered": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "~jonh"}) },
+			options:     []Option{OrderByFuzzySimilarity()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE NOT (similarity(name, \"jonh\") > 0.300000)",
+		},
+		"distinct queries are not ordered": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Distinct("name").Where(map[string]any{"name": "~jonh"}) },
+			options:     []Option{OrderByFuzzySimilarity()},
+			expectedSQL: "SELECT DISTINCT `name` FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.300000",
+		},
+		"other prefix": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonh"}) },
+			options:     []Option{WithFuzzyPrefix("?")},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` = \"~jonh\"",
+		},
+		"untagged field with tagged only": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonh"}) },
+			options:     []Option{TaggedOnly()},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` = \"~jonh\"",
+		},
+		"too long value": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonathan"}) },
+			options:       []Option{WithMaxPatternLength(4)},
+			expectedError: ErrPatternTooLong,
+		},
+		"prefix-only field": {
+			query:         func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"prefix": "~jonh"}) },
+			expectedError: ErrPatternShape,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(append([]Option{WithFuzzyPrefix("~")}, testData.options...)...)
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			if testData.expectedError != nil {
+				err = testData.query(db).Find(&[]ObjectB{}).Error
+				assert.ErrorIs(t, err, testData.expectedError)
+
+				return
+			}
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
+
+func TestGormLike_Initialize_DoesNotOrderFuzzyCounts(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name string
+	}
+
+	// Arrange
+	db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+	_ = db.AutoMigrate(&ObjectB{})
+
+	// Act
+	err := db.Use(New(WithFuzzyPrefix("~"), OrderByFuzzySimilarity()))
+
+	// Assert
+	assert.NoError(t, err)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var count int64
+		return tx.Model(&ObjectB{}).Where(map[string]any{"name": "~jonh"}).Count(&count)
+	})
+	assert.Equal(t, "SELECT count(*) FROM `object_bs` WHERE similarity(name, \"jonh\") > 0.300000", sql)
+}
+
+func TestGormLike_Initialize_ReturnsSameResultsWhenStatementRunsTwice(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name string
+	}
+
+	tests := map[string]struct {
+		query func(*gorm.DB) *gorm.DB
+
+		expected []string
+	}{
+		"escaped wildcard": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": `100\%`}) },
+			expected: []string{"100%"},
+		},
+		"negated escaped wildcard": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": `100\%`}) },
+			expected: []string{"1000"},
+		},
+		"pattern": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "10%"}) },
+			expected: []string{"100%", "1000"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			if err := db.CreateInBatches([]ObjectB{{Name: "100%"}, {Name: "1000"}}, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			err := db.Use(New(WithEscapeCharacter(`\`)))
+			assert.NoError(t, err)
+
+			statement := testData.query(db.Model(&ObjectB{})).Session(&gorm.Session{})
+
+			// Act
+			var count int64
+			countErr := statement.Count(&count).Error
+
+			first := []string{}
+			firstErr := statement.Pluck("name", &first).Error
+
+			second := []string{}
+			secondErr := statement.Pluck("name", &second).Error
+
+			// Assert
+			assert.NoError(t, countErr)
+			assert.NoError(t, firstErr)
+			assert.NoError(t, secondErr)
+
+			assert.Equal(t, int64(len(testData.expected)), count)
+			assert.Equal(t, testData.expected, first)
+			assert.Equal(t, testData.expected, second)
+		})
+	}
+}
+
+func TestGormLike_Initialize_TriggersLikingCorrectlyWithConditionalTag(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string `gormlike:"true"`
+		Other string
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		existing []ObjectB
+		expected []ObjectB
+	}{
+		"simple filter on allowed fields": {
+			filter: map[string]any{
+				"name": "jes%",
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expected: []ObjectB{{Name: "jessica", Other: "abc"}},
+		},
+		"simple filter on disallowed fields": {
+			filter: map[string]any{
+				"other": "%b%",
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+		"multi-filter on allowed fields": {
+			filter: map[string]any{
+				"name": []string{"jes%", "%my"},
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+			expected: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "amy", Other: "def"}},
+		},
+		"multi-filter on disallowed fields": {
+			filter: map[string]any{
+				"other": []string{"%b%", "%c%"},
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(TaggedOnly())
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_AlwaysIgnoresFieldsWithGormLikeFalse(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string `gormlike:"false"`
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		existing []ObjectB
+		expected []ObjectB
+	}{
+		"Normal filter works on never field": {
+			filter: map[string]any{
+				"other": "abc",
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+		},
+		"simple filter on disallowed fields": {
+			filter: map[string]any{
+				"other": "%b%",
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+		"multi-filter on disallowed fields": {
+			filter: map[string]any{
+				"other": []string{"%b%", "%c%"},
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}, {Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(TaggedOnly())
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_TriggersLikingCorrectlyWithSetting(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+		expected []ObjectB
+	}{
+		"like with query set to true": {
+			filter: map[string]any{
+				"name": "jes%",
+			},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set(tagName, true)
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{{Name: "jessica", Other: "abc"}},
+		},
+		"like with query set to false": {
+			filter: map[string]any{
+				"name": "jes%",
+			},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set(tagName, false)
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+		"like with query set to random value": {
+			filter: map[string]any{
+				"name": "jes%",
+			},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set(tagName, "yes")
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+		"like with query unset": {
+			filter: map[string]any{
+				"name": "jes%",
+			},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(SettingOnly())
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			db = testData.query(db)
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+			assert.NoError(t, err)
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestGormLike_Initialize_ProcessUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Other string
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+		expected []ObjectB
+	}{
+		"like with unknown field": {
+			filter: map[string]any{
+				"name":          "jes%",
+				"unknown_field": false,
+			},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set(tagName, true)
+			},
+			existing: []ObjectB{{Name: "jessica", Other: "abc"}},
+			expected: []ObjectB{},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+			plugin := New(SettingOnly())
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			db = testData.query(db)
+
+			// Act
+			err := db.Use(plugin)
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectB
+			err = db.Where(testData.filter).Find(&actual).Error
+			assert.Equal(t, "no such column: unknown_field", err.Error())
+			assert.Nil(t, actual)
+		})
+	}
+}
+
+func BenchmarkGormLike_QueryCallback(b *testing.B) {
+	type ObjectA struct {
+		ID     uuid.UUID
+		Name   string
+		Age    int
+		Tagged string `gormlike:"true;ci;max=3"`
+	}
+
+	benchmarks := map[string]struct {
+		plugin gorm.Plugin
+		filter map[string]any
+	}{
+		"without plugin": {
+			filter: map[string]any{"name": "%a%", "age": "%1%", "tagged": "%b%"},
+		},
+		"without wildcards": {
+			plugin: New(),
+			filter: map[string]any{"name": "a", "age": 1, "tagged": "b"},
+		},
+		"with wildcards": {
+			plugin: New(),
+			filter: map[string]any{"name": "%a%", "age": "%1%", "tagged": "%b%"},
+		},
+		"with wildcards and TaggedOnly": {
+			plugin: New(TaggedOnly()),
+			filter: map[string]any{"name": "%a%", "age": "%1%", "tagged": "%b%"},
+		},
+		"with multiple values": {
+			plugin: New(),
+			filter: map[string]any{"name": []string{"%a%", "b", "%c"}, "id": "%0%"},
+		},
+	}
+
+	for name, benchmark := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			// Arrange
+			db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			if benchmark.plugin != nil {
+				if err := db.Use(benchmark.plugin); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			db = db.Session(&gorm.Session{DryRun: true})
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			// Act
+			for range b.N {
+				if err := db.Where(benchmark.filter).Find(&[]ObjectA{}).Error; err != nil {
+					b.Fatal(err)
+				}
+			}
 		})
 	}
 }
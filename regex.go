@@ -0,0 +1,73 @@
+package gormlike
+
+import (
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
+)
+
+// regexValue returns the regular expression in the value if it's wrapped in the delimiter of WithRegexDelimiter
+func (d *gormLike) regexValue(value string) (string, bool) {
+	if d.regexDelimiter == "" || len(value) <= 2*len(d.regexDelimiter) {
+		return "", false
+	}
+
+	if !strings.HasPrefix(value, d.regexDelimiter) || !strings.HasSuffix(value, d.regexDelimiter) {
+		return "", false
+	}
+
+	return value[len(d.regexDelimiter) : len(value)-len(d.regexDelimiter)], true
+}
+
+// checkRegex returns an error if the regular expression is invalid, may take exponential time to run or exceeds the
+// limits of the plugin that apply to it, the limits in the tag take precedence
+func (d *gormLike) checkRegex(expression string, tag likeTag) error {
+	maxLength := d.maxPatternLength
+	if tag.maxLength > 0 {
+		maxLength = tag.maxLength
+	}
+
+	if maxLength > 0 && utf8.RuneCountInString(expression) > maxLength {
+		return ErrPatternTooLong
+	}
+
+	parsed, err := syntax.Parse(expression, syntax.Perl)
+	if err != nil {
+		return ErrInvalidRegex
+	}
+
+	if hasNestedQuantifiers(parsed, false) {
+		return ErrUnsafeRegex
+	}
+
+	// Without an anchor the expression may match anywhere, just like a pattern with a leading wildcard
+	if (d.forbidLeadingWildcard || tag.forbidLeadingWildcard) && !strings.HasPrefix(expression, "^") {
+		return ErrLeadingWildcard
+	}
+
+	// The shape of a regular expression can't be checked, so they're never allowed on prefix-only fields
+	if tag.prefixOnly {
+		return ErrPatternShape
+	}
+
+	return nil
+}
+
+// hasNestedQuantifiers returns whether the expression repeats a part that is itself repeated, like (a+)+ or (a*b)*.
+// Backtracking engines can take exponential time to find out that these don't match.
+func hasNestedQuantifiers(expression *syntax.Regexp, repeated bool) bool {
+	isQuantifier := expression.Op == syntax.OpStar || expression.Op == syntax.OpPlus ||
+		(expression.Op == syntax.OpRepeat && (expression.Max == -1 || expression.Max > 1))
+
+	if isQuantifier && repeated {
+		return true
+	}
+
+	for _, sub := range expression.Sub {
+		if hasNestedQuantifiers(sub, repeated || isQuantifier) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGormLike_RegexValue_ReturnsExpectedExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		options []Option
+
+		expectedExpression string
+		expectedOk         bool
+	}{
+		"no delimiter configured": {
+			value: "/^ab.*z$/",
+		},
+		"regular expression": {
+			value:              "/^ab.*z$/",
+			options:            []Option{WithRegexDelimiter("/")},
+			expectedExpression: "^ab.*z$",
+			expectedOk:         true,
+		},
+		"multi-character delimiter": {
+			value:              "##^a|b$##",
+			options:            []Option{WithRegexDelimiter("##")},
+			expectedExpression: "^a|b$",
+			expectedOk:         true,
+		},
+		"multi-byte delimiter": {
+			value:              "🍌a+🍌",
+			options:            []Option{WithRegexDelimiter("🍌")},
+			expectedExpression: "a+",
+			expectedOk:         true,
+		},
+		"delimiter inside expression": {
+			value:              "/a/b/",
+			options:            []Option{WithRegexDelimiter("/")},
+			expectedExpression: "a/b",
+			expectedOk:         true,
+		},
+		"only leading delimiter": {
+			value:   "/abc",
+			options: []Option{WithRegexDelimiter("/")},
+		},
+		"only trailing delimiter": {
+			value:   "abc/",
+			options: []Option{WithRegexDelimiter("/")},
+		},
+		"empty expression": {
+			value:   "//",
+			options: []Option{WithRegexDelimiter("/")},
+		},
+		"lone delimiter": {
+			value:   "/",
+			options: []Option{WithRegexDelimiter("/")},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			// Act
+			expression, ok := plugin.regexValue(testData.value)
+
+			// Assert
+			assert.Equal(t, testData.expectedOk, ok)
+			assert.Equal(t, testData.expectedExpression, expression)
+		})
+	}
+}
+
+func TestGormLike_CheckRegex_ReturnsExpectedError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expression string
+		options    []Option
+		tag        likeTag
+
+		expected error
+	}{
+		"anchored expression": {
+			expression: "^ab.*z$",
+		},
+		"repeated group": {
+			expression: "(ab)+",
+		},
+		"optional repetition in group": {
+			expression: "(a?b)*",
+		},
+		"bounded repetition of repetition": {
+			expression: "(a+){1}",
+		},
+		"invalid expression": {
+			expression: "(",
+			expected:   ErrInvalidRegex,
+		},
+		"unsupported syntax": {
+			expression: `(?<=a)b`,
+			expected:   ErrInvalidRegex,
+		},
+		"nested plus": {
+			expression: "(a+)+",
+			expected:   ErrUnsafeRegex,
+		},
+		"nested star": {
+			expression: "^(a*b)*$",
+			expected:   ErrUnsafeRegex,
+		},
+		"nested repetition": {
+			expression: "(x{2,}){3}",
+			expected:   ErrUnsafeRegex,
+		},
+		"deeply nested quantifier": {
+			expression: "((a|b+)c)*",
+			expected:   ErrUnsafeRegex,
+		},
+		"within length limit": {
+			expression: "^a.*",
+			options:    []Option{WithMaxPatternLength(4)},
+		},
+		"too long": {
+			expression: "^ab.*",
+			options:    []Option{WithMaxPatternLength(4)},
+			expected:   ErrPatternTooLong,
+		},
+		"too long for tag": {
+			expression: "^ab.*",
+			tag:        likeTag{maxLength: 4},
+			expected:   ErrPatternTooLong,
+		},
+		"unanchored with leading wildcards forbidden": {
+			expression: "ab",
+			options:    []Option{WithForbidLeadingWildcard()},
+			expected:   ErrLeadingWildcard,
+		},
+		"unanchored with leading wildcards forbidden by tag": {
+			expression: "ab",
+			tag:        likeTag{forbidLeadingWildcard: true},
+			expected:   ErrLeadingWildcard,
+		},
+		"anchored with leading wildcards forbidden": {
+			expression: "^ab",
+			options:    []Option{WithForbidLeadingWildcard()},
+		},
+		"prefix-only tag": {
+			expression: "^ab",
+			tag:        likeTag{prefixOnly: true},
+			expected:   ErrPatternShape,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			plugin, _ := New(testData.options...).(*gormLike)
+
+			// Act
+			err := plugin.checkRegex(testData.expression, testData.tag)
+
+			// Assert
+			assert.Equal(t, testData.expected, err)
+		})
+	}
+}
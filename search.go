@@ -0,0 +1,200 @@
+package gormlike
+
+import (
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Search returns a scope that matches records where any of the columns contains the value, like
+// db.Scopes(gormlike.Search(input, "name", "email")). The value is taken literally and empty values don't filter
+// anything. Columns with the `gormlike:"false"` tag or binary data are left out, columns with the `gormlike:"ci"` tag
+// are searched case-insensitively and columns that aren't text are cast like the plugin does.
+func Search(value string, columns ...string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		value = strings.TrimSpace(value)
+		if value == "" || len(columns) == 0 {
+			return db
+		}
+
+		return db.Where(searchExpression{value: value, columns: columns})
+	}
+}
+
+// SearchWords returns a scope that matches records where every word of the value is contained in any of the columns,
+// like db.Scopes(gormlike.SearchWords(input, "first_name", "last_name")). Words are separated by whitespace and
+// phrases can be searched for by quoting them, like `"john smith" jr`. Columns are searched like in Search, except
+// that if the plugin is registered, words can contain the wildcards of the plugin and its field options are
+// respected as well. Words exceeding the limits of the plugin fail the query like other patterns do, and columns with
+// the prefix-only or noleading tag are left out, since every word is searched for with a leading wildcard.
+func SearchWords(value string, columns ...string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		words := splitWords(value)
+		if len(words) == 0 || len(columns) == 0 {
+			return db
+		}
+
+		exprs := make([]clause.Expression, len(words))
+		for index, word := range words {
+			exprs[index] = searchExpression{value: word, columns: columns, wildcards: true}
+		}
+
+		return db.Where(clause.And(exprs...))
+	}
+}
+
+// splitWords splits the value on whitespace, quoted phrases are kept together
+func splitWords(value string) []string {
+	var words []string
+	var word strings.Builder
+	var quoted bool
+
+	addWord := func() {
+		if trimmed := strings.TrimSpace(word.String()); trimmed != "" {
+			words = append(words, trimmed)
+		}
+
+		word.Reset()
+	}
+
+	for _, character := range value {
+		switch {
+		case character == '"':
+			addWord()
+			quoted = !quoted
+		case unicode.IsSpace(character) && !quoted:
+			addWord()
+		default:
+			word.WriteRune(character)
+		}
+	}
+
+	addWord()
+
+	return words
+}
+
+// searchExpression matches records where any of the columns contains the value, the tags of the columns are
+// looked up when the query is built, because the schema isn't known before then
+type searchExpression struct {
+	value   string
+	columns []string
+
+	// wildcards makes the value a pattern using the wildcards of the registered plugin, if any
+	wildcards bool
+}
+
+// Build writes an OR group of LIKE conditions, or a condition that's always false if none of the columns can be
+// searched
+func (search searchExpression) Build(builder clause.Builder) {
+	exprs := make([]clause.Expression, 0, len(search.columns))
+
+	for _, column := range search.columns {
+		expression, ok, rejected := searchCondition(builder, column, search.value, search.wildcards)
+
+		// The error has been added to the statement already, one is enough
+		if rejected {
+			builder.WriteString("1 = 0")
+			return
+		}
+
+		if ok {
+			exprs = append(exprs, expression)
+		}
+	}
+
+	switch len(exprs) {
+	case 0:
+		builder.WriteString("1 = 0")
+	case 1:
+		exprs[0].Build(builder)
+	default:
+		clause.Or(exprs...).Build(builder)
+	}
+}
+
+// searchCondition returns the condition that matches values of the column containing the value, or false if the
+// column may not be searched according to its tag or type. With wildcards, the value is turned into a pattern by the
+// registered plugin, which also decides whether the column may be searched. It returns true as the last value if the
+// pattern exceeds the limits of the plugin and the error has been added to the statement.
+func searchCondition(builder clause.Builder, column string, value string, wildcards bool) (clause.Expression, bool, bool) {
+	pattern := escapeLiteral(value)
+
+	var caseInsensitive bool
+
+	if stmt, ok := builder.(*gorm.Statement); ok {
+		db := statementDB(stmt)
+		table, name, _ := splitColumn(column)
+		dbField := lookupField(db, table, name)
+
+		if plugin := registeredPlugin(db); wildcards && plugin != nil {
+			if len(plugin.queryFields) > 0 && !columnMatches(db, table, name, plugin.queryFields) {
+				return nil, false, false
+			}
+
+			policy, err := plugin.fieldPolicy(dbField)
+			if err != nil || policy.err != nil {
+				return nil, false, false
+			}
+
+			// Every word gets a leading wildcard, which these tags don't allow
+			if policy.tag.prefixOnly || policy.tag.forbidLeadingWildcard {
+				return nil, false, false
+			}
+
+			caseInsensitive = plugin.caseInsensitive || policy.tag.caseInsensitive
+
+			var wordWildcards int
+			pattern, _, wordWildcards = plugin.convertValue(value, policy.character(plugin.replaceCharacter))
+
+			// The limits apply to the word, not to the wildcards around it
+			if wordWildcards > 0 {
+				if err := plugin.checkPattern(value, pattern, wordWildcards, policy.tag); err != nil {
+					plugin.rejectPattern(stmt.DB, column, value, err)
+
+					if !plugin.degradeRejected {
+						return nil, false, true
+					}
+
+					// A degraded word is searched for literally
+					pattern = escapeLiteral(value)
+				}
+			}
+		} else if dbField != nil {
+			// Invalid tags are reported by the plugin, here they're treated like an empty tag
+			tag, _ := parseTag(dbField.Tag.Get(tagName))
+
+			if tag.disabled || fieldKind(dbField) == unlikeableField {
+				return nil, false, false
+			}
+
+			caseInsensitive = tag.caseInsensitive
+		}
+	}
+
+	like := Like{Column: column, Value: "%" + pattern + "%"}
+
+	if caseInsensitive {
+		return ILike(like), true, false
+	}
+
+	return like, true, false
+}
+
+// registeredPlugin returns the plugin registered in the DB with the QueryOptions of the query applied, or nil if it
+// isn't registered
+func registeredPlugin(db *gorm.DB) *gormLike {
+	plugin, ok := db.Config.Plugins[(&gormLike{}).Name()].(*gormLike)
+	if !ok {
+		return nil
+	}
+
+	// Invalid options are reported by the plugin, here they're ignored
+	if withOptions, err := plugin.withQueryOptions(db); err == nil {
+		return withOptions
+	}
+
+	return plugin
+}
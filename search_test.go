@@ -0,0 +1,458 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/ing-bank/gormtestutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestSearch_QueriesExpectedRecords(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name      string
+		Email     string `gormlike:"ci"`
+		Password  string `gormlike:"false"`
+		Age       int
+		Signature []byte
+	}
+
+	tests := map[string]struct {
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
+	}{
+		"single column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("ess", "name")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%ess%\"",
+			expected:    []ObjectB{{Name: "jessica"}},
+		},
+		"multiple columns": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("am", "name", "age")) },
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`name` LIKE \"%am%\" OR CAST(`age` AS TEXT) LIKE \"%am%\")",
+			expected:    []ObjectB{{Name: "amy", Age: 31}},
+		},
+		"cast column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("25", "name", "age")) },
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`name` LIKE \"%25%\" OR CAST(`age` AS TEXT) LIKE \"%25%\")",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}},
+		},
+		"case-insensitive column": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("EXAMPLE", "name", "email")) },
+			existing:    []ObjectB{{Name: "jessica", Email: "jessica@example.com"}, {Name: "amy", Email: "amy@test.com"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`name` LIKE \"%EXAMPLE%\" OR LOWER(`email`) LIKE LOWER(\"%EXAMPLE%\"))",
+			expected:    []ObjectB{{Name: "jessica", Email: "jessica@example.com"}},
+		},
+		"forbidden and binary columns are left out": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("secret", "name", "password", "signature")) },
+			existing:    []ObjectB{{Name: "jessica", Password: "secret"}, {Name: "secret"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%secret%\"",
+			expected:    []ObjectB{{Name: "secret"}},
+		},
+		"only forbidden columns": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("secret", "password")) },
+			existing:    []ObjectB{{Name: "jessica", Password: "secret"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE 1 = 0",
+			expected:    []ObjectB{},
+		},
+		"value is taken literally": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("%_", "name")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "100%_"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%\\%\\_%\" ESCAPE \"\\\"",
+			expected:    []ObjectB{{Name: "100%_"}},
+		},
+		"empty value": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("  ", "name")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs`",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+		},
+		"no columns": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(Search("jes")) },
+			existing:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs`",
+			expected:    []ObjectB{{Name: "jessica"}, {Name: "amy"}},
+		},
+		"combined with other conditions": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Where("age > ?", 20).Scopes(Search("a", "name", "email")).Where(map[string]any{"age": 25})
+			},
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}, {Name: "john", Age: 25}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE age > 20 AND `age` = 25 AND (`name` LIKE \"%a%\" OR LOWER(`email`) LIKE LOWER(\"%a%\"))",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}},
+		},
+		"combined with or": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Scopes(Search("jes", "name", "email")).Or(map[string]any{"age": 31})
+			},
+			existing:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}, {Name: "john", Age: 25}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`name` LIKE \"%jes%\" OR LOWER(`email`) LIKE LOWER(\"%jes%\")) OR `age` = 31",
+			expected:    []ObjectB{{Name: "jessica", Age: 25}, {Name: "amy", Age: 31}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			var actual []ObjectB
+			err := testData.query(db).Find(&actual).Error
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
+
+func TestSearch_QueriesExpectedRecordsWithPlugin(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name  string
+		Email string
+	}
+
+	// Arrange
+	db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+	_ = db.AutoMigrate(&ObjectB{})
+
+	existing := []ObjectB{{Name: "jessica", Email: "jessica@example.com"}, {Name: "amy", Email: "amy@example.org"}}
+	if err := db.CreateInBatches(existing, 10).Error; err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if err := db.Use(New(WithCharacter("*"))); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	// Act
+	var actual []ObjectB
+	err := db.Scopes(Search("*", "name")).Or(map[string]any{"email": "*.org"}).Find(&actual).Error
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []ObjectB{{Name: "amy", Email: "amy@example.org"}}, actual)
+}
+
+func TestSplitWords_ReturnsExpectedWords(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+
+		expected []string
+	}{
+		"empty": {
+			value: "",
+		},
+		"only whitespace": {
+			value: " \t\n ",
+		},
+		"single word": {
+			value:    "john",
+			expected: []string{"john"},
+		},
+		"multiple words": {
+			value:    "  john \t smith\njr ",
+			expected: []string{"john", "smith", "jr"},
+		},
+		"quoted phrase": {
+			value:    `"john smith" jr`,
+			expected: []string{"john smith", "jr"},
+		},
+		"quoted phrase next to word": {
+			value:    `jr"john smith"sr`,
+			expected: []string{"jr", "john smith", "sr"},
+		},
+		"quoted phrase with surrounding whitespace": {
+			value:    `" john smith "`,
+			expected: []string{"john smith"},
+		},
+		"empty quotes": {
+			value:    `john "" smith`,
+			expected: []string{"john", "smith"},
+		},
+		"unterminated quote": {
+			value:    `jr "john smith`,
+			expected: []string{"jr", "john smith"},
+		},
+		"multi-byte characters": {
+			value:    "josé 🍌",
+			expected: []string{"josé", "🍌"},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := splitWords(testData.value)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestSearchWords_QueriesExpectedRecords(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		FirstName string
+		LastName  string `gormlike:"ci"`
+		Nickname  string `gormlike:"char=🍌"`
+		Password  string `gormlike:"false"`
+	}
+
+	tests := map[string]struct {
+		options  []Option
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectB
+
+		expectedSQL string
+		expected    []ObjectB
+	}{
+		"single word": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("jo", "first_name", "last_name")) },
+			existing:    []ObjectB{{FirstName: "john", LastName: "smith"}, {FirstName: "amy", LastName: "jones"}, {FirstName: "amy", LastName: "smith"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE (`first_name` LIKE \"%jo%\" OR LOWER(`last_name`) LIKE LOWER(\"%jo%\"))",
+			expected:    []ObjectB{{FirstName: "john", LastName: "smith"}, {FirstName: "amy", LastName: "jones"}},
+		},
+		"multiple words": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("john smith", "first_name", "last_name")) },
+			existing: []ObjectB{{FirstName: "john", LastName: "smith"}, {FirstName: "john", LastName: "jones"}, {FirstName: "smith", LastName: "john"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE ((`first_name` LIKE \"%john%\" OR LOWER(`last_name`) LIKE LOWER(\"%john%\")) AND " +
+				"(`first_name` LIKE \"%smith%\" OR LOWER(`last_name`) LIKE LOWER(\"%smith%\")))",
+			expected: []ObjectB{{FirstName: "john", LastName: "smith"}, {FirstName: "smith", LastName: "john"}},
+		},
+		"quoted phrase": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords(`"van der"`, "last_name")) },
+			existing:    []ObjectB{{LastName: "van der Berg"}, {LastName: "der van"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(`last_name`) LIKE LOWER(\"%van der%\")",
+			expected:    []ObjectB{{LastName: "van der Berg"}},
+		},
+		"wildcards without plugin are taken literally": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("j%n", "first_name")) },
+			existing:    []ObjectB{{FirstName: "john"}, {FirstName: "j%n"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `first_name` LIKE \"%j\\%n%\" ESCAPE \"\\\"",
+			expected:    []ObjectB{{FirstName: "j%n"}},
+		},
+		"wildcards of plugin": {
+			options:  []Option{WithCharacter("*")},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("j*n smi", "first_name", "last_name")) },
+			existing: []ObjectB{{FirstName: "john", LastName: "smith"}, {FirstName: "jane", LastName: "jones"}, {FirstName: "jo", LastName: "smith"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE ((`first_name` LIKE \"%j%n%\" OR LOWER(`last_name`) LIKE LOWER(\"%j%n%\")) AND " +
+				"(`first_name` LIKE \"%smi%\" OR LOWER(`last_name`) LIKE LOWER(\"%smi%\")))",
+			expected: []ObjectB{{FirstName: "john", LastName: "smith"}},
+		},
+		"wildcards of tag": {
+			options:     []Option{WithCharacter("*")},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("j🍌y", "nickname")) },
+			existing:    []ObjectB{{Nickname: "jay"}, {Nickname: "joy"}, {Nickname: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `nickname` LIKE \"%j%y%\"",
+			expected:    []ObjectB{{Nickname: "jay"}, {Nickname: "joy"}},
+		},
+		"forbidden column": {
+			options:     []Option{},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("secret", "first_name", "password")) },
+			existing:    []ObjectB{{FirstName: "john", Password: "secret"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `first_name` LIKE \"%secret%\"",
+			expected:    []ObjectB{},
+		},
+		"fields of plugin": {
+			options:     []Option{WithoutFields("last_name")},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("john", "first_name", "last_name")) },
+			existing:    []ObjectB{{FirstName: "john"}, {LastName: "john"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `first_name` LIKE \"%john%\"",
+			expected:    []ObjectB{{FirstName: "john"}},
+		},
+		"query options": {
+			options: []Option{},
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", QueryOptions{Character: "?", CaseInsensitive: true}).
+					Scopes(SearchWords("J?N", "first_name"))
+			},
+			existing:    []ObjectB{{FirstName: "john"}, {FirstName: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs` WHERE LOWER(`first_name`) LIKE LOWER(\"%J%N%\")",
+			expected:    []ObjectB{{FirstName: "john"}},
+		},
+		"empty value": {
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords(` "" `, "first_name")) },
+			existing:    []ObjectB{{FirstName: "john"}, {FirstName: "amy"}},
+			expectedSQL: "SELECT * FROM `object_bs`",
+			expected:    []ObjectB{{FirstName: "john"}, {FirstName: "amy"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if testData.options != nil {
+				if err := db.Use(New(testData.options...)); err != nil {
+					t.Error(err)
+					t.FailNow()
+				}
+			}
+
+			// Act
+			var actual []ObjectB
+			err := testData.query(db).Find(&actual).Error
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
+
+func TestSearchWords_RespectsLimitsOfPlugin(t *testing.T) {
+	t.Parallel()
+
+	type ObjectB struct {
+		Name    string
+		Code    string `gormlike:"prefix-only"`
+		Slug    string `gormlike:"noleading"`
+		Limited string `gormlike:"max=1"`
+	}
+
+	existing := []ObjectB{{Name: "abcd", Code: "abcd", Slug: "abcd", Limited: "abcd"}, {Name: "a*b*c*d*", Code: "x", Slug: "x", Limited: "x"}}
+
+	tests := map[string]struct {
+		options []Option
+		query   func(*gorm.DB) *gorm.DB
+
+		expectedSQL   string
+		expected      []ObjectB
+		expectedError error
+	}{
+		"too many wildcards": {
+			options:       []Option{WithCharacter("*"), WithMaxWildcards(2), WithForbidLeadingWildcard()},
+			query:         func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("*a*b*c*d*", "name")) },
+			expectedError: ErrTooManyWildcards,
+		},
+		"leading wildcard": {
+			options:       []Option{WithCharacter("*"), WithForbidLeadingWildcard()},
+			query:         func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("*bc", "name")) },
+			expectedError: ErrLeadingWildcard,
+		},
+		"pattern too long": {
+			options:       []Option{WithCharacter("*"), WithMaxPatternLength(3)},
+			query:         func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("a*cd", "name")) },
+			expectedError: ErrPatternTooLong,
+		},
+		"limits of tag": {
+			options:       []Option{WithCharacter("*")},
+			query:         func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("a*c*", "name", "limited")) },
+			expectedError: ErrTooManyWildcards,
+		},
+		"within limits": {
+			options:     []Option{WithCharacter("*"), WithMaxWildcards(2), WithForbidLeadingWildcard()},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("a*c", "name")) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%a%c%\"",
+			expected:    []ObjectB{existing[0], existing[1]},
+		},
+		"words without wildcards": {
+			options:     []Option{WithCharacter("*"), WithMaxPatternLength(3)},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("abcd", "name")) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%abcd%\"",
+			expected:    []ObjectB{existing[0]},
+		},
+		"degraded": {
+			options:     []Option{WithCharacter("*"), WithMaxWildcards(2), DegradeRejectedPatterns()},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("a*b*c*d*", "name")) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%a*b*c*d*%\"",
+			expected:    []ObjectB{existing[1]},
+		},
+		"prefix-only and noleading columns": {
+			options:     []Option{WithCharacter("*")},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("bc", "name", "code", "slug")) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE `name` LIKE \"%bc%\"",
+			expected:    []ObjectB{existing[0]},
+		},
+		"only prefix-only columns": {
+			options:     []Option{WithCharacter("*")},
+			query:       func(db *gorm.DB) *gorm.DB { return db.Scopes(SearchWords("abcd", "code")) },
+			expectedSQL: "SELECT * FROM `object_bs` WHERE 1 = 0",
+			expected:    []ObjectB{},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := gormtestutil.NewMemoryDatabase(t, gormtestutil.WithName(t.Name()))
+			_ = db.AutoMigrate(&ObjectB{})
+
+			if err := db.CreateInBatches(existing, 10).Error; err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if err := db.Use(New(testData.options...)); err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			// Act
+			var actual []ObjectB
+			err := testData.query(db).Find(&actual).Error
+
+			// Assert
+			if testData.expectedError != nil {
+				assert.ErrorIs(t, err, testData.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return testData.query(tx).Find(&[]ObjectB{})
+			})
+			assert.Equal(t, testData.expectedSQL, sql)
+		})
+	}
+}
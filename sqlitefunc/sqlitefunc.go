@@ -0,0 +1,135 @@
+// Package sqlitefunc registers the SQL functions that gormlike needs on SQLite, which doesn't have them built in. It
+// depends on github.com/mattn/go-sqlite3, which is why it's not part of gormlike itself.
+//
+// Open your database using DriverName, or call Register in the ConnectHook of your own driver:
+//
+//	db, err := gorm.Open(&sqlite.Dialector{DriverName: sqlitefunc.DriverName, DSN: "test.db"}, &gorm.Config{})
+package sqlitefunc
+
+import (
+	"database/sql"
+	"regexp"
+	"sync"
+
+	gormlike "github.com/survivorbat/gorm-like"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// DriverName is the name of a go-sqlite3 driver that has all functions registered
+const DriverName = "sqlite3_gormlike"
+
+//nolint:gochecknoinits // Drivers can only be registered once
+func init() {
+	sql.Register(DriverName, &sqlite3.SQLiteDriver{ConnectHook: Register})
+}
+
+// Register registers all functions on the connection:
+//
+//   - unaccent(value) removes diacritics from the value, for gormlike.AccentInsensitive
+//   - regexp(expression, value) matches the value against a Go regular expression, for gormlike.WithRegexDelimiter
+//   - similarity(first, second) returns the trigram similarity of the values, for gormlike.WithFuzzyPrefix
+func Register(conn *sqlite3.SQLiteConn) error {
+	if err := conn.RegisterFunc("unaccent", unaccent, true); err != nil {
+		return err
+	}
+
+	if err := conn.RegisterFunc("regexp", matchRegex, true); err != nil {
+		return err
+	}
+
+	return conn.RegisterFunc("similarity", similarity, true)
+}
+
+// unaccent removes diacritics from text values, other values are returned as-is
+func unaccent(value any) any {
+	switch value := value.(type) {
+	case string:
+		return gormlike.Unaccent(value)
+	case []byte:
+		// NULL is given as a nil byte slice
+		if value == nil {
+			return nil
+		}
+
+		return gormlike.Unaccent(string(value))
+	default:
+		return value
+	}
+}
+
+// similarity returns the similarity of two text values like gormlike.Similarity, or NULL if either is NULL
+func similarity(first any, second any) any {
+	firstText, ok := text(first)
+	if !ok {
+		return nil
+	}
+
+	secondText, ok := text(second)
+	if !ok {
+		return nil
+	}
+
+	return gormlike.Similarity(firstText, secondText)
+}
+
+// text returns the value if it's text, false is returned for NULL and other types
+func text(value any) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case []byte:
+		// NULL is given as a nil byte slice
+		return string(value), value != nil
+	default:
+		return "", false
+	}
+}
+
+// maxCachedExpressions limits the amount of compiled regular expressions kept in memory
+const maxCachedExpressions = 128
+
+// expressionCache holds compiled regular expressions, since SQLite calls regexp once for every row
+var expressionCache = struct {
+	sync.Mutex
+	expressions map[string]*regexp.Regexp
+}{expressions: map[string]*regexp.Regexp{}}
+
+// matchRegex returns whether the value matches the regular expression, SQLite calls it for value REGEXP expression.
+// Values that aren't text, like NULL, never match. gormlike casts non-text columns to text itself.
+func matchRegex(expression string, value any) (bool, error) {
+	valueText, ok := text(value)
+	if !ok {
+		return false, nil
+	}
+
+	compiled, err := compileRegex(expression)
+	if err != nil {
+		return false, err
+	}
+
+	return compiled.MatchString(valueText), nil
+}
+
+// compileRegex returns the compiled regular expression from the cache, which is emptied once it's full
+func compileRegex(expression string) (*regexp.Regexp, error) {
+	expressionCache.Lock()
+	defer expressionCache.Unlock()
+
+	if compiled, ok := expressionCache.expressions[expression]; ok {
+		return compiled, nil
+	}
+
+	compiled, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expressionCache.expressions) >= maxCachedExpressions {
+		expressionCache.expressions = map[string]*regexp.Regexp{}
+	}
+
+	expressionCache.expressions[expression] = compiled
+
+	return compiled, nil
+}
@@ -0,0 +1,551 @@
+package sqlitefunc
+
+import (
+	"database/sql"
+	"testing"
+
+	gormlike "github.com/survivorbat/gorm-like"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newDatabase returns an in-memory database that uses the driver of this package
+func newDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(&sqlite.Dialector{DriverName: DriverName, DSN: ":memory:"}, &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every connection to :memory: gets its own database
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB.SetMaxOpenConns(1)
+
+	return db
+}
+
+func TestUnaccent_ReturnsExpectedValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query string
+
+		expected sql.NullString
+	}{
+		"text": {
+			query:    "SELECT unaccent('José Ñoño Ærø')",
+			expected: sql.NullString{String: "Jose Nono Ærø", Valid: true},
+		},
+		"number": {
+			query:    "SELECT unaccent(12)",
+			expected: sql.NullString{String: "12", Valid: true},
+		},
+		"null": {
+			query: "SELECT unaccent(NULL)",
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+
+			// Act
+			var result sql.NullString
+			err := db.Raw(testData.query).Row().Scan(&result)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestMatchRegex_ReturnsExpectedValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query string
+
+		expected      bool
+		expectedError bool
+	}{
+		"match": {
+			query:    "SELECT 'jessica' REGEXP '^j.*a$'",
+			expected: true,
+		},
+		"no match": {
+			query: "SELECT 'amy' REGEXP '^j.*a$'",
+		},
+		"case-insensitive flag": {
+			query:    "SELECT 'Jessica' REGEXP '(?i)^j'",
+			expected: true,
+		},
+		"number": {
+			query:    "SELECT 42 REGEXP '^4'",
+			expected: false,
+		},
+		"null": {
+			query: "SELECT NULL REGEXP '.*'",
+		},
+		"invalid expression": {
+			query:         "SELECT 'amy' REGEXP '('",
+			expectedError: true,
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+
+			// Act
+			var result sql.NullBool
+			err := db.Raw(testData.query).Row().Scan(&result)
+
+			// Assert
+			if testData.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, result.Bool)
+		})
+	}
+}
+
+func TestRegister_MakesRegexQueriesWork(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name  string
+		Age   int
+		Other string `gormlike:"ci"`
+	}
+
+	tests := map[string]struct {
+		options  []gormlike.Option
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectA
+
+		expected []ObjectA
+	}{
+		"regular expression": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^j.*a$/"}) },
+			existing: []ObjectA{{Name: "jessica"}, {Name: "john"}, {Name: "amanda"}},
+			expected: []ObjectA{{Name: "jessica"}},
+		},
+		"negated regular expression": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "/^j/"}) },
+			existing: []ObjectA{{Name: "jessica"}, {Name: "john"}, {Name: "amanda"}},
+			expected: []ObjectA{{Name: "amanda"}},
+		},
+		"multi-value query": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": []string{"amanda", "/^jo/"}}) },
+			existing: []ObjectA{{Name: "jessica"}, {Name: "john"}, {Name: "amanda"}},
+			expected: []ObjectA{{Name: "john"}, {Name: "amanda"}},
+		},
+		"number column": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"age": "/^4[0-9]$/"}) },
+			existing: []ObjectA{{Name: "jessica", Age: 42}, {Name: "john", Age: 4}, {Name: "amanda", Age: 142}},
+			expected: []ObjectA{{Name: "jessica", Age: 42}},
+		},
+		"case-insensitive tag": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "/^J/"}) },
+			existing: []ObjectA{{Other: "jessica"}, {Other: "amanda"}},
+			expected: []ObjectA{{Other: "jessica"}},
+		},
+		"case-sensitive by default": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^J/"}) },
+			existing: []ObjectA{{Name: "jessica"}, {Name: "Jessica"}},
+			expected: []ObjectA{{Name: "Jessica"}},
+		},
+		"untagged field with tagged only": {
+			options:  []gormlike.Option{gormlike.TaggedOnly()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/^j/"}) },
+			existing: []ObjectA{{Name: "jessica"}, {Name: "/^j/"}},
+			expected: []ObjectA{{Name: "/^j/"}},
+		},
+		"degraded unsafe expression": {
+			options:  []gormlike.Option{gormlike.DegradeRejectedPatterns()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "/(a+)+$/"}) },
+			existing: []ObjectA{{Name: "aaaa"}, {Name: "/(a+)+$/"}},
+			expected: []ObjectA{{Name: "/(a+)+$/"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+			_ = db.AutoMigrate(&ObjectA{})
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			err := db.Use(gormlike.New(append([]gormlike.Option{gormlike.WithRegexDelimiter("/")}, testData.options...)...))
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectA
+			err = testData.query(db).Find(&actual).Error
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestSimilarity_ReturnsExpectedValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query string
+
+		expected sql.NullFloat64
+	}{
+		"identical": {
+			query:    "SELECT similarity('john', 'John')",
+			expected: sql.NullFloat64{Float64: 1, Valid: true},
+		},
+		"similar": {
+			query:    "SELECT similarity('jonh', 'john')",
+			expected: sql.NullFloat64{Float64: 0.25, Valid: true},
+		},
+		"different": {
+			query:    "SELECT similarity('john', 'amy')",
+			expected: sql.NullFloat64{Float64: 0, Valid: true},
+		},
+		"null": {
+			query: "SELECT similarity(NULL, 'john')",
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+
+			// Act
+			var result sql.NullFloat64
+			err := db.Raw(testData.query).Row().Scan(&result)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestRegister_MakesFuzzyQueriesWork(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name  string
+		Other string `gormlike:"fuzzy=0.45"`
+	}
+
+	existing := []ObjectA{
+		{Name: "jonathan", Other: "jonathan"},
+		{Name: "jonathon", Other: "jonathon"},
+		{Name: "jon", Other: "jon"},
+		{Name: "amy", Other: "amy"},
+	}
+
+	tests := map[string]struct {
+		options []gormlike.Option
+		query   func(*gorm.DB) *gorm.DB
+
+		expected []ObjectA
+	}{
+		"fuzzy value": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonathon"}) },
+			expected: []ObjectA{existing[0], existing[1], existing[2]},
+		},
+		"threshold of tag": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "~jonathon"}) },
+			expected: []ObjectA{existing[0], existing[1]},
+		},
+		"threshold of option": {
+			options:  []gormlike.Option{gormlike.WithFuzzyThreshold(0.9)},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonathon"}) },
+			expected: []ObjectA{existing[1]},
+		},
+		"negated": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "~jonathon"}) },
+			expected: []ObjectA{existing[3]},
+		},
+		"ordered by similarity": {
+			options:  []gormlike.Option{gormlike.OrderByFuzzySimilarity()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "~jonathon"}) },
+			expected: []ObjectA{existing[1], existing[0], existing[2]},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+			_ = db.AutoMigrate(&ObjectA{})
+
+			if err := db.CreateInBatches(existing, 10).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			err := db.Use(gormlike.New(append([]gormlike.Option{gormlike.WithFuzzyPrefix("~")}, testData.options...)...))
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectA
+			err = testData.query(db).Find(&actual).Error
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+
+			var count int64
+			err = testData.query(db).Model(&ObjectA{}).Count(&count).Error
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(testData.expected)), count)
+		})
+	}
+}
+
+func TestRegister_OrdersFirstAndLastBySimilarity(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		ID    int
+		Name  string
+		Other string
+	}
+
+	existing := []ObjectA{
+		{ID: 1, Name: "jonathan", Other: "b"},
+		{ID: 2, Name: "jonathon", Other: "a"},
+		{ID: 3, Name: "jon", Other: "c"},
+	}
+
+	tests := map[string]struct {
+		query func(*gorm.DB, *ObjectA) *gorm.DB
+
+		expected ObjectA
+	}{
+		"first": {
+			query: func(db *gorm.DB, result *ObjectA) *gorm.DB {
+				return db.Where(map[string]any{"name": "~jonathon"}).First(result)
+			},
+			expected: existing[1],
+		},
+		"last": {
+			query: func(db *gorm.DB, result *ObjectA) *gorm.DB {
+				return db.Where(map[string]any{"name": "~jonathon"}).Last(result)
+			},
+			expected: existing[2],
+		},
+		"existing order takes precedence": {
+			query: func(db *gorm.DB, result *ObjectA) *gorm.DB {
+				return db.Order("other DESC").Where(map[string]any{"name": "~jonathon"}).First(result)
+			},
+			expected: existing[2],
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+			_ = db.AutoMigrate(&ObjectA{})
+
+			if err := db.CreateInBatches(existing, 10).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			err := db.Use(gormlike.New(gormlike.WithFuzzyPrefix("~"), gormlike.OrderByFuzzySimilarity()))
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual ObjectA
+			err = testData.query(db, &actual).Error
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
+
+func TestRegister_OrdersReusedStatementsBySimilarity(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name string
+	}
+
+	existing := []ObjectA{{Name: "jonathan"}, {Name: "jon"}, {Name: "jonathon"}}
+	expected := []string{"jonathon", "jonathan", "jon"}
+
+	// Arrange
+	db := newDatabase(t)
+	_ = db.AutoMigrate(&ObjectA{})
+
+	if err := db.CreateInBatches(existing, 10).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.Use(gormlike.New(gormlike.WithFuzzyPrefix("~"), gormlike.OrderByFuzzySimilarity()))
+	assert.NoError(t, err)
+
+	statement := db.Model(&ObjectA{}).Where(map[string]any{"name": "~jonathon"}).Session(&gorm.Session{})
+
+	// Act
+	first := []string{}
+	firstErr := statement.Pluck("name", &first).Error
+
+	second := []string{}
+	secondErr := statement.Pluck("name", &second).Error
+
+	var count int64
+	countErr := statement.Count(&count).Error
+
+	afterCount := []string{}
+	afterCountErr := statement.Pluck("name", &afterCount).Error
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.NoError(t, secondErr)
+	assert.NoError(t, countErr)
+	assert.NoError(t, afterCountErr)
+
+	assert.Equal(t, expected, first)
+	assert.Equal(t, expected, second)
+	assert.Equal(t, int64(len(expected)), count)
+	assert.Equal(t, expected, afterCount)
+}
+
+func TestRegister_MakesAccentInsensitiveQueriesWork(t *testing.T) {
+	t.Parallel()
+
+	type ObjectA struct {
+		Name  string
+		Other string `gormlike:"ai"`
+	}
+
+	tests := map[string]struct {
+		options  []gormlike.Option
+		query    func(*gorm.DB) *gorm.DB
+		existing []ObjectA
+
+		expected []ObjectA
+	}{
+		"accent-insensitive option": {
+			options:  []gormlike.Option{gormlike.AccentInsensitive()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "jos%"}) },
+			existing: []ObjectA{{Name: "josé"}, {Name: "jòsh"}, {Name: "amy"}},
+			expected: []ObjectA{{Name: "josé"}, {Name: "jòsh"}},
+		},
+		"accented value": {
+			options:  []gormlike.Option{gormlike.AccentInsensitive()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "%sé"}) },
+			existing: []ObjectA{{Name: "jose"}, {Name: "josé"}, {Name: "josè"}, {Name: "amy"}},
+			expected: []ObjectA{{Name: "jose"}, {Name: "josé"}, {Name: "josè"}},
+		},
+		"accent-insensitive tag": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": "%ç%"}) },
+			existing: []ObjectA{{Other: "façade"}, {Other: "facade"}, {Other: "fade"}},
+			expected: []ObjectA{{Other: "façade"}, {Other: "facade"}},
+		},
+		"accent-insensitive tag in multi-value query": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"other": []string{"fade", "%ç%"}}) },
+			existing: []ObjectA{{Other: "façade"}, {Other: "facade"}, {Other: "fade"}, {Other: "amy"}},
+			expected: []ObjectA{{Other: "façade"}, {Other: "facade"}, {Other: "fade"}},
+		},
+		"accent-insensitive setting": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:accent_insensitive", true).Where(map[string]any{"name": "%e"})
+			},
+			existing: []ObjectA{{Name: "josé"}, {Name: "amy"}},
+			expected: []ObjectA{{Name: "josé"}},
+		},
+		"accent-insensitive query options": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Set("gormlike:options", gormlike.QueryOptions{AccentInsensitive: true}).
+					Where(map[string]any{"name": "%e"})
+			},
+			existing: []ObjectA{{Name: "josé"}, {Name: "amy"}},
+			expected: []ObjectA{{Name: "josé"}},
+		},
+		"accent-sensitive by default": {
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "%e"}) },
+			existing: []ObjectA{{Name: "josé"}, {Name: "mike"}},
+			expected: []ObjectA{{Name: "mike"}},
+		},
+		"case- and accent-insensitive": {
+			options:  []gormlike.Option{gormlike.AccentInsensitive(), gormlike.CaseInsensitive()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Where(map[string]any{"name": "%É%"}) },
+			existing: []ObjectA{{Name: "josé"}, {Name: "amy"}},
+			expected: []ObjectA{{Name: "josé"}},
+		},
+		"not like": {
+			options:  []gormlike.Option{gormlike.AccentInsensitive()},
+			query:    func(db *gorm.DB) *gorm.DB { return db.Not(map[string]any{"name": "%e"}) },
+			existing: []ObjectA{{Name: "josé"}, {Name: "amy"}},
+			expected: []ObjectA{{Name: "amy"}},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			db := newDatabase(t)
+			_ = db.AutoMigrate(&ObjectA{})
+
+			if err := db.CreateInBatches(testData.existing, 10).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			err := db.Use(gormlike.New(testData.options...))
+
+			// Assert
+			assert.NoError(t, err)
+
+			var actual []ObjectA
+			err = testData.query(db).Find(&actual).Error
+
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+}
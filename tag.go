@@ -0,0 +1,152 @@
+package gormlike
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// likeTag contains the settings of a field, parsed from a tag like `gormlike:"true;ci;prefix-only;max=3;char=*"`
+type likeTag struct {
+	// enabled is set using "true" and makes the field like-able when TaggedOnly is used
+	enabled bool
+
+	// disabled is set using "false" and prevents the field from ever being LIKE-d
+	disabled bool
+
+	// caseInsensitive is set using "ci" and makes the field like-able and case-insensitive
+	caseInsensitive bool
+
+	// accentInsensitive is set using "ai" and makes the field like-able and accent-insensitive
+	accentInsensitive bool
+
+	// fullText is set using "fts" and makes the field like-able using full-text search instead of LIKE
+	fullText bool
+
+	// forbidLeadingWildcard is set using "noleading" and rejects patterns starting with a wildcard
+	forbidLeadingWildcard bool
+
+	// prefixOnly is set using "prefix-only" and rejects all patterns except those with a single trailing wildcard
+	prefixOnly bool
+
+	// maxWildcards is set using "max=3" and overrides WithMaxWildcards
+	maxWildcards int
+
+	// maxLength is set using "maxlen=20" and overrides WithMaxPatternLength
+	maxLength int
+
+	// character is set using "char=*" and overrides WithCharacter
+	character string
+
+	// fuzzyThreshold is set using "fuzzy=0.5" and overrides WithFuzzyThreshold
+	fuzzyThreshold float64
+}
+
+// Errors of parsePositiveInt and parseThreshold, which are wrapped in ErrInvalidTag
+var (
+	errNotPositive  = errors.New("must be a positive number")
+	errNotThreshold = errors.New("must be a number between 0 and 1")
+)
+
+// tagSettings contains all settings a `gormlike` tag may contain and whether they're given as key=argument
+var tagSettings = map[string]bool{
+	"true":               false,
+	"false":              false,
+	caseInsensitiveTag:   false,
+	accentInsensitiveTag: false,
+	fullTextTag:          false,
+	"noleading":          false,
+	"prefix-only":        false,
+	"max":                true,
+	"maxlen":             true,
+	"char":               true,
+	"fuzzy":              true,
+}
+
+// parseTag parses the value of a `gormlike` tag, settings are separated by a semicolon. It returns an error wrapping
+// ErrInvalidTag if the tag contains unknown settings or invalid arguments.
+func parseTag(value string) (likeTag, error) {
+	var result likeTag
+
+	for _, setting := range strings.Split(value, ";") {
+		setting = strings.TrimSpace(setting)
+		key, argument, hasArgument := strings.Cut(setting, "=")
+
+		// Empty settings, like the one after a trailing semicolon, are ignored
+		if key == "" {
+			continue
+		}
+
+		takesArgument, known := tagSettings[key]
+
+		switch {
+		case !known:
+			return likeTag{}, fmt.Errorf("%w: unknown setting %q", ErrInvalidTag, key)
+		case hasArgument && !takesArgument:
+			return likeTag{}, fmt.Errorf("%w: setting %q does not take an argument", ErrInvalidTag, key)
+		case takesArgument && argument == "":
+			return likeTag{}, fmt.Errorf("%w: setting %q requires an argument", ErrInvalidTag, key)
+		}
+
+		var err error
+
+		switch key {
+		case "true":
+			result.enabled = true
+		case "false":
+			result.disabled = true
+		case caseInsensitiveTag:
+			result.enabled = true
+			result.caseInsensitive = true
+		case accentInsensitiveTag:
+			result.enabled = true
+			result.accentInsensitive = true
+		case fullTextTag:
+			result.enabled = true
+			result.fullText = true
+		case "noleading":
+			result.forbidLeadingWildcard = true
+		case "prefix-only":
+			result.prefixOnly = true
+		case "max":
+			result.maxWildcards, err = parsePositiveInt(argument)
+		case "maxlen":
+			result.maxLength, err = parsePositiveInt(argument)
+		case "char":
+			result.character = argument
+		case "fuzzy":
+			result.fuzzyThreshold, err = parseThreshold(argument)
+		}
+
+		if err != nil {
+			return likeTag{}, fmt.Errorf("%w: setting %q %w", ErrInvalidTag, setting, err)
+		}
+	}
+
+	if result.enabled && result.disabled {
+		return likeTag{}, fmt.Errorf("%w: field can't be both like-able and not like-able", ErrInvalidTag)
+	}
+
+	return result, nil
+}
+
+// parsePositiveInt parses the argument of a limit in a tag
+func parsePositiveInt(argument string) (int, error) {
+	result, err := strconv.Atoi(argument)
+	if err != nil || result <= 0 {
+		return 0, errNotPositive
+	}
+
+	return result, nil
+}
+
+// parseThreshold parses the similarity threshold in a tag, which has to be larger than 0 and at most 1
+func parseThreshold(argument string) (float64, error) {
+	result, err := strconv.ParseFloat(argument, 64)
+	if err != nil || result <= 0 || result > 1 {
+		return 0, errNotThreshold
+	}
+
+	return result, nil
+}
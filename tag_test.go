@@ -0,0 +1,150 @@
+package gormlike
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTag_ReturnsExpectedSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+
+		expected likeTag
+	}{
+		"empty": {
+			value:    "",
+			expected: likeTag{},
+		},
+		"true": {
+			value:    "true",
+			expected: likeTag{enabled: true},
+		},
+		"false": {
+			value:    "false",
+			expected: likeTag{disabled: true},
+		},
+		"case-insensitive": {
+			value:    "ci",
+			expected: likeTag{enabled: true, caseInsensitive: true},
+		},
+		"limits": {
+			value:    "true;max=3;maxlen=20;noleading",
+			expected: likeTag{enabled: true, maxWildcards: 3, maxLength: 20, forbidLeadingWildcard: true},
+		},
+		"limits with spaces": {
+			value:    " max=3 ; maxlen=20 ",
+			expected: likeTag{maxWildcards: 3, maxLength: 20},
+		},
+		"full": {
+			value: "true;ci;prefix-only;max=3;char=*",
+			expected: likeTag{
+				enabled:         true,
+				caseInsensitive: true,
+				prefixOnly:      true,
+				maxWildcards:    3,
+				character:       "*",
+			},
+		},
+		"multi-byte character": {
+			value:    "char=🍌",
+			expected: likeTag{character: "🍌"},
+		},
+		"accent-insensitive": {
+			value:    "ai",
+			expected: likeTag{enabled: true, accentInsensitive: true},
+		},
+		"full-text": {
+			value:    "fts;maxlen=20",
+			expected: likeTag{enabled: true, fullText: true, maxLength: 20},
+		},
+		"fuzzy threshold": {
+			value:    "fuzzy=0.45",
+			expected: likeTag{fuzzyThreshold: 0.45},
+		},
+		"trailing semicolon": {
+			value:    "true;",
+			expected: likeTag{enabled: true},
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result, err := parseTag(testData.value)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestParseTag_ReturnsErrorOnInvalidTag(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+
+		expected string
+	}{
+		"unknown setting": {
+			value:    "true;cI",
+			expected: `gormlike: invalid tag: unknown setting "cI"`,
+		},
+		"unexpected argument": {
+			value:    "ci=true",
+			expected: `gormlike: invalid tag: setting "ci" does not take an argument`,
+		},
+		"missing argument": {
+			value:    "max",
+			expected: `gormlike: invalid tag: setting "max" requires an argument`,
+		},
+		"empty argument": {
+			value:    "char=",
+			expected: `gormlike: invalid tag: setting "char" requires an argument`,
+		},
+		"invalid number": {
+			value:    "maxlen=ten",
+			expected: `gormlike: invalid tag: setting "maxlen=ten" must be a positive number`,
+		},
+		"negative number": {
+			value:    "max=-1",
+			expected: `gormlike: invalid tag: setting "max=-1" must be a positive number`,
+		},
+		"invalid threshold": {
+			value:    "fuzzy=high",
+			expected: `gormlike: invalid tag: setting "fuzzy=high" must be a number between 0 and 1`,
+		},
+		"zero threshold": {
+			value:    "fuzzy=0",
+			expected: `gormlike: invalid tag: setting "fuzzy=0" must be a number between 0 and 1`,
+		},
+		"threshold above one": {
+			value:    "fuzzy=1.5",
+			expected: `gormlike: invalid tag: setting "fuzzy=1.5" must be a number between 0 and 1`,
+		},
+		"conflicting settings": {
+			value:    "true;false",
+			expected: "gormlike: invalid tag: field can't be both like-able and not like-able",
+		},
+	}
+
+	for name, testData := range tests {
+		testData := testData
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result, err := parseTag(testData.value)
+
+			// Assert
+			assert.Equal(t, likeTag{}, result)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+			assert.EqualError(t, err, testData.expected)
+		})
+	}
+}